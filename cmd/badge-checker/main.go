@@ -4,12 +4,17 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"os"
+	"slices"
 	"sort"
 	"strings"
 
+	"github.com/jredh-dev/nba2k26/pkg/archetypes"
 	"github.com/jredh-dev/nba2k26/pkg/attributes"
 	"github.com/jredh-dev/nba2k26/pkg/badges"
 	"github.com/jredh-dev/nba2k26/pkg/scraper"
@@ -26,6 +31,17 @@ func main() {
 	minTier := flag.String("min-tier", "Bronze", "Minimum tier to display (Bronze, Silver, Gold, HoF, Legendary)")
 	showAll := flag.Bool("all", false, "Show all badges including unavailable (None tier)")
 	showAttrs := flag.Bool("show-attributes", false, "Show calculated attribute values")
+	requirements := flag.String("requirements", "", "Badge requirements JSON file or directory of patch versions (default: embedded)")
+	patch := flag.String("patch", "", "Patch version to load from a --requirements directory (default: latest)")
+	currentFile := flag.String("current", "", "JSON file of current ratings (evaluate badges at today's ratings instead of caps)")
+	targetStr := flag.String("target", "", "Badge tiers to plan upgrades for, e.g. \"Posterizer=Gold,Dimer=HoF\" (needs --current)")
+	rangesFile := flag.String("cap-ranges", "", "JSON file of uncertain caps, e.g. {\"Vertical\": {\"min\": 70, \"max\": 80}}; adds each badge's tier odds")
+	unmodeled := flag.String("unmodeled", "", "Cap range for attributes the calculators do not model yet, e.g. 40-99; adds each badge's tier odds")
+	showLoadout := flag.Bool("loadout", false, "Print the best badge loadout under the equip limits")
+	priorityStr := flag.String("priority", "", "Loadout priority list, e.g. \"Dimer,Posterizer=Gold\" (=Tier caps the spend; implies --loadout)")
+	limitsFile := flag.String("limits", "", "Badge equip limits JSON file (default: embedded placeholder estimate)")
+	nearMiss := flag.Int("near-miss", 0, "Also list badges within N attribute points of their next tier")
+	format := flag.String("format", "text", "Output format: text, or json/yaml for the structured badge report")
 
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Usage: badge-checker [OPTIONS]\n\n")
@@ -41,6 +57,18 @@ func main() {
 		fmt.Fprintf(os.Stderr, "  badge-checker --height 7-0 --wingspan 7-3 --weight 260 --badge Posterizer\n\n")
 		fmt.Fprintf(os.Stderr, "  # Show all badges including unavailable\n")
 		fmt.Fprintf(os.Stderr, "  badge-checker --height 7-0 --wingspan 7-3 --weight 260 --all\n\n")
+		fmt.Fprintf(os.Stderr, "  # Current ratings and the cheapest upgrades to reach Posterizer Gold\n")
+		fmt.Fprintf(os.Stderr, "  badge-checker --height 7-0 --wingspan 7-3 --weight 260 --current ratings.json --target Posterizer=Gold\n\n")
+		fmt.Fprintf(os.Stderr, "  # Badges within 5 points of their next tier\n")
+		fmt.Fprintf(os.Stderr, "  badge-checker --height 7-0 --wingspan 7-3 --weight 260 --near-miss 5\n\n")
+		fmt.Fprintf(os.Stderr, "  # Tier odds when unmodeled caps could be anywhere from 40 to 99\n")
+		fmt.Fprintf(os.Stderr, "  badge-checker --height 7-0 --wingspan 7-3 --weight 260 --unmodeled 40-99\n\n")
+		fmt.Fprintf(os.Stderr, "  # Best loadout under the equip limits, Dimer first\n")
+		fmt.Fprintf(os.Stderr, "  badge-checker --height 7-0 --wingspan 7-3 --weight 260 --priority Dimer,Posterizer=Gold\n\n")
+		fmt.Fprintf(os.Stderr, "  # Badge report as JSON\n")
+		fmt.Fprintf(os.Stderr, "  badge-checker --height 7-0 --wingspan 7-3 --weight 260 --format json\n\n")
+		fmt.Fprintf(os.Stderr, "  # Try thresholds from a patch\n")
+		fmt.Fprintf(os.Stderr, "  badge-checker --height 7-0 --wingspan 7-3 --weight 260 --requirements patches/ --patch 1.04\n\n")
 	}
 
 	flag.Parse()
@@ -53,20 +81,61 @@ func main() {
 	}
 
 	// Parse height and wingspan
-	height, err := parseHeight(*heightStr)
+	height, err := attributes.ParseInches(*heightStr)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error parsing height: %v\n", err)
 		os.Exit(1)
 	}
 
-	wingspan, err := parseHeight(*wingspanStr)
+	wingspan, err := attributes.ParseInches(*wingspanStr)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error parsing wingspan: %v\n", err)
 		os.Exit(1)
 	}
 
+	// Parse minimum tier
+	minTierValue, err := badges.ParseBadgeTier(*minTier)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Calculate attribute caps using attribute system
-	attrs := calculateAttributeCaps(height, wingspan, *weight)
+	attrs := attributes.CenterCaps(height, *weight, wingspan)
+	attrs.Position = *position
+
+	// Initialize badge calculator
+	opts, err := requirementsOptions(*requirements, *patch)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	calc, err := badges.NewCalculator(opts...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing badge calculator: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Structured report mode: only the report goes to stdout
+	if *format != badges.FormatText {
+		if *badge != "" || *currentFile != "" || *targetStr != "" {
+			fmt.Fprintf(os.Stderr, "Error: --format %s cannot be combined with --badge, --current, or --target\n", *format)
+			os.Exit(1)
+		}
+		if err := writeReport(os.Stdout, calc, attrs, *format, *category, minTierValue, *showAll); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Label the build from its cap profile
+	classifier, err := archetypes.NewClassifier()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading archetype rules: %v\n", err)
+		os.Exit(1)
+	}
+	archetype := classifier.Classify(attrs)
 
 	// Print build summary
 	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
@@ -74,7 +143,8 @@ func main() {
 	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
 	fmt.Printf("Height:   %d\" (%s)\n", height, formatHeight(height))
 	fmt.Printf("Wingspan: %d\" (%s)\n", wingspan, formatHeight(wingspan))
-	fmt.Printf("Weight:   %d lbs\n\n", *weight)
+	fmt.Printf("Weight:   %d lbs\n", *weight)
+	fmt.Printf("Archetype: %s (%s)\n\n", archetype.Archetype, archetype.Explanation())
 
 	// Show attributes if requested
 	if *showAttrs {
@@ -82,19 +152,61 @@ func main() {
 		fmt.Println()
 	}
 
-	// Initialize badge calculator
-	calc, err := badges.NewCalculator()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error initializing badge calculator: %v\n", err)
+	if calc.Source() != "embedded" {
+		fmt.Printf("Requirements: %s (version %s)\n\n", calc.Source(), calc.DataVersion())
+	}
+
+	// Current-rating mode: evaluate today's ratings against the build's caps
+	if *targetStr != "" && *currentFile == "" {
+		fmt.Fprintf(os.Stderr, "Error: --target needs --current\n")
 		os.Exit(1)
 	}
+	if *currentFile != "" {
+		current, err := loadRatings(*currentFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading current ratings: %v\n", err)
+			os.Exit(1)
+		}
+		if _, err := calc.CurrentBadges(current, attrs); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
 
-	// Parse minimum tier
-	minTierValue := parseTier(*minTier)
+		if *targetStr != "" {
+			targets, err := parseTargets(*targetStr)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			plan, err := calc.PlanUpgrades(current, attrs, targets)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error planning upgrades: %v\n", err)
+				os.Exit(1)
+			}
+			printPlan(plan)
+			return
+		}
+
+		fmt.Printf("Evaluating current ratings from %s (caps from the build)\n\n", *currentFile)
+		ratings := *current
+		ratings.Position, ratings.Height, ratings.Wingspan, ratings.Weight = attrs.Position, attrs.Height, attrs.Wingspan, attrs.Weight
+		attrs = &ratings
+	}
 
 	// Handle specific badge query
 	if *badge != "" {
 		tier, err := calc.GetBadgeTier(*badge, attrs)
+		var notFound *badges.NotFoundError
+		if errors.As(err, &notFound) {
+			fmt.Fprintf(os.Stderr, "Error: badge %q not found\n", notFound.Name)
+			if len(notFound.Suggestions) > 0 {
+				fmt.Fprintf(os.Stderr, "\nDid you mean:\n")
+				for _, name := range notFound.Suggestions {
+					fmt.Fprintf(os.Stderr, "  %s\n", name)
+				}
+			}
+			os.Exit(1)
+		}
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
@@ -105,12 +217,14 @@ func main() {
 
 	// Handle category filter
 	var badgeTiers map[string]badges.BadgeTier
+	var categoryFilter *badges.BadgeCategory
 	if *category != "" {
 		cat, err := parseCategory(*category)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
+		categoryFilter = &cat
 		badgeTiers = calc.GetBadgesByCategory(cat, attrs)
 	} else {
 		badgeTiers = calc.GetAvailableBadges(attrs)
@@ -133,10 +247,172 @@ func main() {
 	// Print results
 	if len(badgeTiers) == 0 {
 		fmt.Printf("No badges available at %s tier or higher.\n", *minTier)
-		return
+	} else {
+		printBadges(grouped, len(badgeTiers))
+	}
+
+	if *nearMiss > 0 {
+		printNearMisses(calc, attrs, *nearMiss, categoryFilter)
+	}
+
+	if *rangesFile != "" || *unmodeled != "" {
+		uncertain, err := loadCapRanges(attrs, *rangesFile, *unmodeled)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading cap ranges: %v\n", err)
+			os.Exit(1)
+		}
+		printTierOdds(calc, uncertain, categoryFilter)
+	}
+
+	if *showLoadout || *priorityStr != "" {
+		limits, err := loadLimits(*limitsFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading badge limits: %v\n", err)
+			os.Exit(1)
+		}
+		priorities, err := parsePriorities(*priorityStr)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		loadout, err := calc.Loadout(attrs, limits, priorities, true)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		printLoadout(loadout, limits)
+	}
+}
+
+// loadLimits reads equip limits from a file, or the embedded estimate if path is empty
+func loadLimits(path string) (*badges.EquipLimits, error) {
+	if path == "" {
+		return badges.DefaultEquipLimits()
+	}
+	return badges.LoadEquipLimits(path)
+}
+
+// parsePriorities parses "Badge,Badge=Tier" into a loadout priority list
+func parsePriorities(s string) ([]badges.Priority, error) {
+	var priorities []badges.Priority
+	if s == "" {
+		return priorities, nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		name, tierStr, capped := strings.Cut(part, "=")
+		p := badges.Priority{Badge: strings.TrimSpace(name)}
+		if capped {
+			tier, err := badges.ParseBadgeTier(strings.TrimSpace(tierStr))
+			if err != nil {
+				return nil, err
+			}
+			p.MaxTier = tier
+		}
+		priorities = append(priorities, p)
+	}
+	return priorities, nil
+}
+
+// printLoadout prints the equipped badges in priority order and the ones that did not fit
+func printLoadout(loadout *badges.Loadout, limits *badges.EquipLimits) {
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	if limits.Estimated {
+		fmt.Printf("ESTIMATED Loadout (%s; %s limits)\n", loadout.Summary(limits), limits.Version)
+		fmt.Printf("⚠️  Placeholder equip limits, not confirmed in-game: the real best loadout may differ\n")
+	} else {
+		fmt.Printf("Loadout (%s; %s limits)\n", loadout.Summary(limits), limits.Version)
+	}
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+
+	for _, e := range loadout.Equipped {
+		rank := "  "
+		if e.Priority > 0 {
+			rank = fmt.Sprintf("%d.", e.Priority)
+		}
+		fmt.Printf("  %s %s %s\n", rank, tierEmoji(e.Tier), e)
+	}
+	for _, name := range loadout.Unavailable {
+		fmt.Printf("  ❌ %s: not reachable with this build\n", name)
+	}
+	if len(loadout.Unequipped) > 0 {
+		fmt.Printf("\nDid not fit:\n")
+		for _, e := range loadout.Unequipped {
+			fmt.Printf("  ❌ %s (%s available)\n", e.Badge, e.Available)
+		}
+	}
+	if limits.Note != "" {
+		fmt.Printf("\nNote: %s\n", limits.Note)
+	}
+}
+
+// loadCapRanges combines the build's caps with ranges from a file and a range for unmodeled (0) caps
+func loadCapRanges(attrs *scraper.AttributeCaps, path, unmodeled string) (*badges.UncertainCaps, error) {
+	uncertain := &badges.UncertainCaps{Caps: *attrs, Ranges: make(map[string]badges.CapRange)}
+
+	if unmodeled != "" {
+		lo, hi, ok := strings.Cut(unmodeled, "-")
+		var r badges.CapRange
+		if _, err := fmt.Sscanf(lo+" "+hi, "%d %d", &r.Min, &r.Max); !ok || err != nil {
+			return nil, fmt.Errorf("invalid --unmodeled range %q (use 40-99)", unmodeled)
+		}
+		for _, name := range scraper.AttributeNames {
+			if v, _ := attrs.Get(name); v == 0 {
+				uncertain.Ranges[name] = r
+			}
+		}
+	}
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var ranges map[string]badges.CapRange
+		if err := json.Unmarshal(data, &ranges); err != nil {
+			return nil, fmt.Errorf("parsing JSON: %w", err)
+		}
+		for name, r := range ranges {
+			uncertain.Ranges[name] = r
+		}
+	}
+	return uncertain, nil
+}
+
+// printTierOdds prints each reachable badge's guaranteed tier and the odds of higher tiers
+func printTierOdds(calc *badges.Calculator, uncertain *badges.UncertainCaps, category *badges.BadgeCategory) {
+	all, err := calc.AllTierOdds(uncertain)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("Tier Odds (%d uncertain caps)\n", len(uncertain.Ranges))
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+
+	shown := 0
+	for _, odds := range all {
+		if odds.Best == badges.BadgeTierNone {
+			continue
+		}
+		if b, err := calc.Badge(odds.Badge); err != nil || (category != nil && b.Category != *category) {
+			continue
+		}
+		mark := "✅"
+		if !odds.Certain() {
+			mark = "❓"
+		}
+		fmt.Printf("  %s %s\n", mark, odds)
+		shown++
 	}
+	if shown == 0 {
+		fmt.Printf("No badges reachable within the cap ranges.\n")
+	}
+}
 
-	fmt.Printf("Available Badges (%d):\n\n", len(badgeTiers))
+// printBadges prints the available badges per category, highest tier first
+func printBadges(grouped map[badges.BadgeCategory][]badgeInfo, total int) {
+	fmt.Printf("Available Badges (%d):\n\n", total)
 
 	categories := []badges.BadgeCategory{
 		badges.BadgeCategoryFinishing,
@@ -171,161 +447,220 @@ func main() {
 	}
 }
 
+// printNearMisses prints the badges within n attribute points of their next tier
+func printNearMisses(calc *badges.Calculator, attrs *scraper.AttributeCaps, n int, category *badges.BadgeCategory) {
+	misses, err := calc.NearMisses(attrs, n)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error finding near misses: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("Near Misses (within %d points)\n", n)
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+
+	shown := 0
+	for _, m := range misses {
+		if category != nil && m.Category != *category {
+			continue
+		}
+		fmt.Printf("  %s %s\n", tierEmoji(m.Next), m)
+		shown++
+	}
+	if shown == 0 {
+		fmt.Printf("No badges within %d points of their next tier.\n", n)
+	}
+}
+
 // badgeInfo holds badge name and tier for sorting
 type badgeInfo struct {
 	name string
 	tier badges.BadgeTier
 }
 
-// calculateAttributeCaps uses the attribute system to calculate all caps
-func calculateAttributeCaps(height, wingspan, weight int) *scraper.AttributeCaps {
-	return &scraper.AttributeCaps{
-		Height:           height,
-		Wingspan:         wingspan,
-		Weight:           weight,
-		CloseShot:        attributes.CloseShot(height, weight, wingspan),
-		DrivingLayup:     attributes.DrivingLayup(height, weight, wingspan),
-		DrivingDunk:      attributes.DrivingDunk(height, weight, wingspan),
-		StandingDunk:     attributes.StandingDunk(height, weight, wingspan),
-		PostControl:      attributes.PostControl(height, weight, wingspan),
-		MidRangeShot:     attributes.MidRangeShot(height, weight, wingspan),
-		ThreePointShot:   attributes.ThreePointShot(height, weight, wingspan),
-		FreeThrow:        attributes.FreeThrow(height, weight, wingspan),
-		PassAccuracy:     attributes.PassAccuracy(height, weight, wingspan),
-		BallHandle:       attributes.BallHandle(height, weight, wingspan),
-		SpeedWithBall:    attributes.SpeedWithBall(height, weight, wingspan),
-		InteriorDefense:  attributes.InteriorDefense(height, weight, wingspan),
-		PerimeterDefense: attributes.PerimeterDefense(height, weight, wingspan),
-		Steal:            attributes.Steal(height, weight, wingspan),
-		Block:            attributes.Block(height, weight, wingspan),
-		OffensiveRebound: attributes.OffensiveRebound(height, weight, wingspan),
-		DefensiveRebound: attributes.DefensiveRebound(height, weight, wingspan),
-		Speed:            attributes.Speed(height, weight, wingspan),
-		Agility:          attributes.Agility(height, weight, wingspan),
-		Strength:         attributes.Strength(height, weight, wingspan),
-		Vertical:         attributes.Vertical(height, weight, wingspan),
+// printBadgeDetails prints a badge's tier with every requirement, the limiting attribute, and the next tier
+func printBadgeDetails(name string, tier badges.BadgeTier, attrs *scraper.AttributeCaps, calc *badges.Calculator) {
+	exp, err := calc.ExplainBadge(name, attrs)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
 	}
-}
 
-// printBadgeDetails prints detailed information about a specific badge
-func printBadgeDetails(name string, tier badges.BadgeTier, attrs *scraper.AttributeCaps, calc *badges.Calculator) {
 	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
-	fmt.Printf("%s\n", name)
+	fmt.Printf("%s\n", exp.Name)
 	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
-	fmt.Printf("Tier: %s %s\n\n", tierEmoji(tier), tier)
-
-	if tier == badges.BadgeTierNone {
-		fmt.Printf("This badge is not available for this build.\n")
-		fmt.Printf("Check badge requirements and adjust your build's height, weight, or wingspan.\n")
+	if badge, err := calc.Badge(name); err == nil && badge.Description != "" {
+		fmt.Printf("%s\n\n", badge.Description)
+	}
+	fmt.Printf("Tier: %s %s\n", tierEmoji(tier), tier)
+	fmt.Printf("Category: %s\n", categoryName(exp.Category))
+	if exp.Type == badges.BadgeTypePrimary {
+		fmt.Printf("Type: Primary (every requirement must be met)\n")
 	} else {
-		fmt.Printf("✅ This badge is available for this build.\n")
+		fmt.Printf("Type: Secondary (any requirement can be met)\n")
 	}
-}
-
-// groupByCategory groups badges by their category
-func groupByCategory(badgeTiers map[string]badges.BadgeTier, calc *badges.Calculator) map[badges.BadgeCategory][]badgeInfo {
-	grouped := make(map[badges.BadgeCategory][]badgeInfo)
+	if exp.MinHeight > 0 || exp.MaxHeight > 0 {
+		fmt.Printf("Height: %s - %s\n", formatHeight(exp.MinHeight), formatHeight(exp.MaxHeight))
+	}
+	fmt.Println()
 
-	// Get all badge requirements to access category information
-	for name, tier := range badgeTiers {
-		// Get badge tier to access requirement data (we ignore tier since we already have it)
-		// This is a workaround - ideally Calculator would expose category lookup
-		cat := inferCategoryFromName(name)
-		grouped[cat] = append(grouped[cat], badgeInfo{name, tier})
+	if !exp.HeightAllowed {
+		fmt.Printf("❌ This build's height (%s) is outside the badge's height range.\n", formatHeight(attrs.Height))
+		return
 	}
 
-	return grouped
-}
+	fmt.Printf("%-20s %5s   %6s %6s %6s %6s %6s   %s\n", "Attribute", "Value", "Bronze", "Silver", "Gold", "HoF", "Legend", "Tier")
+	for _, r := range exp.Requirements {
+		marker := ""
+		if r.Attribute == exp.Bottleneck {
+			marker = "  ⬅ bottleneck"
+		} else if slices.Contains(strings.Split(exp.BestPath, " + "), r.Attribute) {
+			marker = "  ⬅ best path"
+		}
 
-// inferCategoryFromName infers badge category from scraped data
-// This is a temporary solution until Calculator exposes category metadata
-func inferCategoryFromName(badgeName string) badges.BadgeCategory {
-	// Inside Scoring badges
-	insideScoring := []string{"Aerial Wizard", "Backdown Punisher", "Dream Shake", "Drop Stepper",
-		"Fast Twitch", "Fearless Finisher", "Giant Slayer", "Masher", "Post Spin Technician",
-		"Posterizer", "Pro Touch", "Putback Boss", "Rise Up", "Slithery Finisher",
-		"Limitless Takeoff", "Acrobat", "Bully", "Tear Dropper", "Layup Mixmaster"}
+		fmt.Printf("%-20s %5d  ", r.Attribute, r.Value)
+		for t := badges.BadgeTierBronze; t <= badges.BadgeTierLegendary; t++ {
+			if r.Thresholds[t] == 0 {
+				fmt.Printf(" %6s", "-")
+			} else {
+				fmt.Printf(" %6d", r.Thresholds[t])
+			}
+		}
+		band := ""
+		if r.MinHeight != exp.MinHeight || r.MaxHeight != exp.MaxHeight {
+			band = fmt.Sprintf(" (%s - %s band)", formatHeight(r.MinHeight), formatHeight(r.MaxHeight))
+		}
+		fmt.Printf("   %s%s%s\n", r.Tier, band, marker)
+	}
+	fmt.Println()
 
-	// Outside Scoring badges
-	outsideScoring := []string{"Agent 3", "Blinders", "Catch & Shoot", "Claymore", "Corner Specialist",
-		"Deadeye", "Guard Up", "Middy Magician", "Space Creator"}
+	if len(exp.Branch) > 0 {
+		fmt.Printf("Earned via: %s\n", strings.Join(exp.Branch, " + "))
+	}
 
-	// Playmaking badges
-	playmaking := []string{"Anchor", "Bail Out", "Break Starter", "Dimer", "Handles For Days",
-		"Mismatch Expert", "Needle Threader", "Post Playmaker", "Quick First Step",
-		"Special Delivery", "Unpluckable"}
+	switch {
+	case tier == badges.BadgeTierLegendary:
+		fmt.Printf("✅ Maxed out at Legendary.\n")
+	case exp.NextTier == badges.BadgeTierNone:
+		fmt.Printf("%s is not reachable for this badge's requirements.\n", tier+1)
+	default:
+		needs := make([]string, len(exp.NextTierGaps))
+		for i, g := range exp.NextTierGaps {
+			needs[i] = fmt.Sprintf("%s +%d", g.Attribute, g.Points())
+		}
+		fmt.Printf("Next tier: %s needs %d points (%s)\n", exp.NextTier, exp.PointsToNextTier, strings.Join(needs, ", "))
+	}
+}
 
-	// Defense badges
-	defense := []string{"Ankle Braces", "Challenger", "Clamps", "Glove", "Interceptor",
-		"Lightning Reflexes", "Off-Ball Pest", "Pick Dodger", "Post Lockdown", "Work Horse",
-		"Chase Down Artist", "Pogo Stick", "Intimidator"}
+// requirementsOptions selects the badge requirements source from --requirements and --patch
+func requirementsOptions(path, patch string) ([]badges.Option, error) {
+	if path == "" {
+		if patch != "" {
+			return nil, fmt.Errorf("--patch needs a --requirements directory")
+		}
+		return nil, nil
+	}
 
-	// Rebounding badges
-	rebounding := []string{"Boxout Beast", "Rebound Chaser"}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return []badges.Option{badges.WithRequirementsDir(path, patch)}, nil
+	}
+	if patch != "" {
+		return nil, fmt.Errorf("--patch needs a --requirements directory, %s is a file", path)
+	}
+	return []badges.Option{badges.WithRequirementsFile(path)}, nil
+}
 
-	// Physical/General Offense badges
-	physicals := []string{"Brick Wall", "Bulldozer", "Physical Finisher"}
+// loadRatings reads current ratings as JSON (same keys as scraped caps, e.g. "driving_dunk")
+func loadRatings(path string) (*scraper.AttributeCaps, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
 
-	// All-Around badges
-	allAround := []string{"Versatility"}
+	var ratings scraper.AttributeCaps
+	if err := json.Unmarshal(data, &ratings); err != nil {
+		return nil, fmt.Errorf("parsing JSON: %w", err)
+	}
+	return &ratings, nil
+}
 
-	for _, b := range insideScoring {
-		if b == badgeName {
-			return badges.BadgeCategoryFinishing
+// parseTargets parses "Badge=Tier,Badge=Tier" into badge tiers
+func parseTargets(s string) (map[string]badges.BadgeTier, error) {
+	targets := make(map[string]badges.BadgeTier)
+	for _, part := range strings.Split(s, ",") {
+		name, tierStr, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid target %q (use Badge=Tier)", part)
 		}
-	}
-	for _, b := range outsideScoring {
-		if b == badgeName {
-			return badges.BadgeCategoryShooting
+		tier, err := badges.ParseBadgeTier(strings.TrimSpace(tierStr))
+		if err != nil {
+			return nil, err
 		}
+		targets[strings.TrimSpace(name)] = tier
 	}
-	for _, b := range playmaking {
-		if b == badgeName {
-			return badges.BadgeCategoryPlaymaking
-		}
+	return targets, nil
+}
+
+// writeReport encodes the build's badge report, filtered by category and tier
+func writeReport(w io.Writer, calc *badges.Calculator, attrs *scraper.AttributeCaps, format, category string, minTier badges.BadgeTier, showAll bool) error {
+	if showAll {
+		minTier = badges.BadgeTierNone
 	}
-	for _, b := range defense {
-		if b == badgeName {
-			return badges.BadgeCategoryDefense
-		}
+	report, err := calc.Report(attrs, minTier)
+	if err != nil {
+		return err
 	}
-	for _, b := range rebounding {
-		if b == badgeName {
-			return badges.BadgeCategoryRebounding
+	if category != "" {
+		cat, err := parseCategory(category)
+		if err != nil {
+			return err
 		}
+		report = report.Filter(func(b badges.BadgeResult) bool { return b.Category == cat })
 	}
-	for _, b := range physicals {
-		if b == badgeName {
-			return badges.BadgeCategoryPhysicals
-		}
+	return report.Encode(w, format)
+}
+
+// printPlan prints the upgrades that reach the targeted badge tiers
+func printPlan(plan *badges.UpgradePlan) {
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("Upgrade Plan (%d points)\n", plan.Points)
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+
+	if len(plan.Upgrades) == 0 {
+		fmt.Printf("✅ Current ratings already reach every target.\n")
 	}
-	for _, b := range allAround {
-		if b == badgeName {
-			return badges.BadgeCategoryAllAround
-		}
+	for _, u := range plan.Upgrades {
+		fmt.Printf("  %s\n", u)
 	}
+	fmt.Println()
 
-	// Default to finishing if unknown
-	return badges.BadgeCategoryFinishing
+	names := make([]string, 0, len(plan.Tiers))
+	for name := range plan.Tiers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Printf("  %s %s (%s)\n", tierEmoji(plan.Tiers[name]), name, plan.Tiers[name])
+	}
 }
 
-// parseHeight parses height from "7-0" or "84" format
-func parseHeight(s string) (int, error) {
-	// Try feet-inches format first (e.g., "7-0")
-	var feet, in int
-	n, err := fmt.Sscanf(s, "%d-%d", &feet, &in)
-	if err == nil && n == 2 {
-		return feet*12 + in, nil
-	}
+// groupByCategory groups badges by their category
+func groupByCategory(badgeTiers map[string]badges.BadgeTier, calc *badges.Calculator) map[badges.BadgeCategory][]badgeInfo {
+	grouped := make(map[badges.BadgeCategory][]badgeInfo)
 
-	// Try simple inches format (e.g., "84")
-	var inches int
-	n, err = fmt.Sscanf(s, "%d", &inches)
-	if err == nil && n == 1 {
-		return inches, nil
+	for name, tier := range badgeTiers {
+		badge, err := calc.Badge(name)
+		if err != nil {
+			continue
+		}
+		grouped[badge.Category] = append(grouped[badge.Category], badgeInfo{name, tier})
 	}
 
-	return 0, fmt.Errorf("invalid height format %q (use 7-0 or 84)", s)
+	return grouped
 }
 
 // formatHeight formats inches as feet-inches
@@ -335,24 +670,6 @@ func formatHeight(inches int) string {
 	return fmt.Sprintf("%d'%d\"", feet, in)
 }
 
-// parseTier converts string to BadgeTier
-func parseTier(s string) badges.BadgeTier {
-	switch strings.ToLower(s) {
-	case "bronze":
-		return badges.BadgeTierBronze
-	case "silver":
-		return badges.BadgeTierSilver
-	case "gold":
-		return badges.BadgeTierGold
-	case "hof", "halloffame":
-		return badges.BadgeTierHallOfFame
-	case "legendary", "legend":
-		return badges.BadgeTierLegendary
-	default:
-		return badges.BadgeTierBronze
-	}
-}
-
 // parseCategory converts string to BadgeCategory
 func parseCategory(s string) (badges.BadgeCategory, error) {
 	switch strings.ToLower(s) {
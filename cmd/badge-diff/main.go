@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/builds"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+func main() {
+	var (
+		oldFile    = flag.String("old", "", "Previous badge requirements JSON (default: embedded data)")
+		newFile    = flag.String("new", "", "Updated badge requirements JSON")
+		inputFile  = flag.String("input", "", "Scraped builds JSON file for the impact (default: attribute calculators)")
+		weightStep = flag.Int("weight-step", 5, "Weight step in lbs when using the calculators")
+		examples   = flag.Int("examples", 3, "Builds to list per badge for gained and lost tiers")
+	)
+	flag.Parse()
+
+	if *newFile == "" {
+		fmt.Fprintf(os.Stderr, "Error: --new is required\n\n")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	var oldOpts []badges.Option
+	if *oldFile != "" {
+		oldOpts = append(oldOpts, badges.WithRequirementsFile(*oldFile))
+	}
+	oldCalc, err := badges.NewCalculator(oldOpts...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading old requirements: %v\n", err)
+		os.Exit(1)
+	}
+	newCalc, err := badges.NewCalculator(badges.WithRequirementsFile(*newFile))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading new requirements: %v\n", err)
+		os.Exit(1)
+	}
+
+	source := fmt.Sprintf("calculators, %d lb steps", *weightStep)
+	var caps []scraper.AttributeCaps
+	if *inputFile != "" {
+		caps, err = scraper.LoadCaps(*inputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading builds: %v\n", err)
+			os.Exit(1)
+		}
+		source = *inputFile
+	} else {
+		caps = builds.CenterCaps(*weightStep)
+	}
+
+	diff := badges.DiffRequirements(oldCalc, newCalc)
+
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("Badge requirements: %s (%s) → %s (%s)\n",
+		oldCalc.Source(), oldCalc.DataVersion(), newCalc.Source(), newCalc.DataVersion())
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+
+	if diff.Empty() {
+		fmt.Println("✅ No requirement changes")
+		return
+	}
+
+	for _, b := range diff.Added {
+		fmt.Printf("+ %s (%s)\n", b.Name, b.Category)
+	}
+	for _, b := range diff.Removed {
+		fmt.Printf("- %s (%s)\n", b.Name, b.Category)
+	}
+	for _, bd := range diff.Changed {
+		fmt.Printf("~ %s\n", bd.Name)
+		for _, f := range bd.Fields {
+			fmt.Printf("    %s\n", f)
+		}
+		for _, t := range bd.Thresholds {
+			fmt.Printf("    %s\n", t)
+		}
+		for _, h := range bd.Heights {
+			fmt.Printf("    height %s\n", h)
+		}
+		for _, r := range bd.AddedRequirements {
+			fmt.Printf("    + %s\n", r)
+		}
+		for _, r := range bd.RemovedRequirements {
+			fmt.Printf("    - %s\n", r)
+		}
+	}
+	fmt.Println()
+
+	impacts, err := builds.RequirementsImpact(oldCalc, newCalc, caps)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("Impact (%d builds, %s)\n", len(caps), source)
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	if len(impacts) == 0 {
+		fmt.Println("✅ No build changes tier")
+		return
+	}
+	for _, impact := range impacts {
+		fmt.Printf("%s: %d builds gain, %d lose\n", impact.Badge, len(impact.Gained), len(impact.Lost))
+		printChanges("✅", impact.Gained, *examples)
+		printChanges("❌", impact.Lost, *examples)
+	}
+}
+
+// printChanges lists up to limit build changes with a marker
+func printChanges(mark string, changes []builds.BuildChange, limit int) {
+	for i, c := range changes {
+		if i == limit {
+			fmt.Printf("    ... %d more\n", len(changes)-limit)
+			break
+		}
+		fmt.Printf("    %s %s: %s → %s\n", mark, c.Build, c.From, c.To)
+	}
+}
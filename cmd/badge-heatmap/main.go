@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/builds"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+func main() {
+	var (
+		badge      = flag.String("badge", "", "Badge to map (default: every badge)")
+		weightsStr = flag.String("weights", "220,240,260,280", "Comma-separated weight slices in lbs")
+		inputFile  = flag.String("input", "", "Scraped builds JSON file (default: attribute calculators)")
+		showAll    = flag.Bool("all", false, "Print grids for unreachable badges too")
+	)
+	flag.Parse()
+
+	weights, err := parseWeights(*weightsStr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	source := "calculators"
+	var caps []scraper.AttributeCaps
+	if *inputFile != "" {
+		caps, err = scraper.LoadCaps(*inputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading builds: %v\n", err)
+			os.Exit(1)
+		}
+		source = *inputFile
+	} else {
+		caps = builds.CenterCaps(1)
+	}
+
+	calc, err := badges.NewCalculator()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing badge calculator: %v\n", err)
+		os.Exit(1)
+	}
+
+	var names []string
+	if *badge != "" {
+		names = []string{*badge}
+	} else {
+		for _, b := range calc.Badges() {
+			names = append(names, b.Name)
+		}
+	}
+
+	var maps []*builds.Heatmap
+	for _, name := range names {
+		hm, err := builds.BadgeHeatmap(calc, caps, name, weights)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		maps = append(maps, hm)
+	}
+
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("Badge reach across %d builds (%s)\n", len(caps), source)
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	reachable := 0
+	for _, hm := range maps {
+		if hm.MaxTier > badges.BadgeTierNone {
+			reachable++
+			fmt.Printf("✅ %-22s up to %s\n", hm.Badge, hm.MaxTier)
+		} else {
+			fmt.Printf("❌ %-22s unreachable\n", hm.Badge)
+		}
+	}
+	fmt.Printf("\n%d/%d badges reachable\n\n", reachable, len(maps))
+	fmt.Printf("Cells: - None, B Bronze, S Silver, G Gold, H Hall of Fame, L Legendary, · no build\n\n")
+
+	for _, hm := range maps {
+		if hm.MaxTier == badges.BadgeTierNone && !*showAll && *badge == "" {
+			continue
+		}
+		fmt.Print(hm)
+	}
+}
+
+// parseWeights parses a comma-separated list of weights
+func parseWeights(s string) ([]int, error) {
+	var weights []int
+	for _, part := range strings.Split(s, ",") {
+		w, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight %q", part)
+		}
+		weights = append(weights, w)
+	}
+	return weights, nil
+}
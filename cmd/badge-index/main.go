@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jredh-dev/nba2k26/pkg/attributes"
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/builds"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+func main() {
+	var (
+		indexFile  = flag.String("index", "data/badge_index.json", "Index file (built on first use and whenever the requirements or builds change)")
+		query      = flag.String("query", "", "Builds meeting every requirement, e.g. \"Anchor=Legendary,Post Lockdown=Gold\"")
+		buildStr   = flag.String("build", "", "Show every badge tier for one build, e.g. 7-0/7-3/260")
+		limit      = flag.Int("limit", 20, "Maximum builds to list (0 for all)")
+		inputFile  = flag.String("input", "", "Scraped builds JSON file (default: attribute calculators)")
+		weightStep = flag.Int("weight-step", 5, "Weight step in lbs when using the calculators")
+		rebuild    = flag.Bool("rebuild", false, "Rebuild the index even if it is current")
+	)
+	flag.Parse()
+
+	var caps []scraper.AttributeCaps
+	var err error
+	if *inputFile != "" {
+		caps, err = scraper.LoadCaps(*inputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading builds: %v\n", err)
+			os.Exit(1)
+		}
+	} else {
+		caps = builds.CenterCaps(*weightStep)
+	}
+
+	calc, err := badges.NewCalculator()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing badge calculator: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *rebuild {
+		if err := os.Remove(*indexFile); err != nil && !os.IsNotExist(err) {
+			fmt.Fprintf(os.Stderr, "Error removing index: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	start := time.Now()
+	ix, rebuilt, err := builds.LoadOrBuildIndex(*indexFile, calc, caps)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading index: %v\n", err)
+		os.Exit(1)
+	}
+	action := "Loaded"
+	if rebuilt {
+		action = "Built"
+	}
+
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("Badge Index (%d builds, requirements %s)\n", ix.Len(), ix.DataVersion)
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("%s %s in %s\n", action, *indexFile, time.Since(start).Round(time.Microsecond))
+
+	if *buildStr != "" {
+		b, err := attributes.ParseBuild(*buildStr)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if err := printBuild(ix, calc, b); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	if *query != "" {
+		reqs, err := parseQuery(*query)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		start := time.Now()
+		matches, err := ix.Query(reqs...)
+		var notFound *badges.NotFoundError
+		if errors.As(err, &notFound) {
+			fmt.Fprintf(os.Stderr, "Error: badge %q not found\n", notFound.Name)
+			if len(notFound.Suggestions) > 0 {
+				fmt.Fprintf(os.Stderr, "\nDid you mean:\n")
+				for _, name := range notFound.Suggestions {
+					fmt.Fprintf(os.Stderr, "  %s\n", name)
+				}
+			}
+			os.Exit(1)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		elapsed := time.Since(start)
+		printMatches(reqs, matches, *limit, elapsed)
+	}
+}
+
+// printBuild prints every badge the build earns, grouped by category
+func printBuild(ix *builds.Index, calc *badges.Calculator, b attributes.Build) error {
+	tiers, err := ix.Tiers(b)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("\n%s:\n", b)
+	var category *badges.BadgeCategory
+	for _, badge := range calc.Badges() {
+		tier := tiers[badge.Name]
+		if tier == badges.BadgeTierNone {
+			continue
+		}
+		if category == nil || *category != badge.Category {
+			c := badge.Category
+			category = &c
+			fmt.Printf("  %s:\n", c)
+		}
+		fmt.Printf("    %-13s %s\n", tier, badge.Name)
+	}
+	return nil
+}
+
+// printMatches prints the builds that meet the query
+func printMatches(reqs []builds.Requirement, matches []attributes.Build, limit int, elapsed time.Duration) {
+	parts := make([]string, len(reqs))
+	for i, r := range reqs {
+		parts[i] = r.String()
+	}
+	fmt.Printf("\n%s: %d builds (%s)\n", strings.Join(parts, " + "), len(matches), elapsed.Round(time.Microsecond))
+	if len(matches) == 0 {
+		fmt.Println("  ❌ No build meets every requirement")
+		return
+	}
+	for i, b := range matches {
+		if limit > 0 && i == limit {
+			fmt.Printf("  ... %d more (raise --limit)\n", len(matches)-limit)
+			break
+		}
+		fmt.Printf("  ✅ %s\n", b)
+	}
+}
+
+// parseQuery parses "Badge=Tier,..." into index requirements
+func parseQuery(s string) ([]builds.Requirement, error) {
+	var reqs []builds.Requirement
+	for _, part := range strings.Split(s, ",") {
+		name, tierStr, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid requirement %q (use Badge=Tier)", part)
+		}
+		tier, err := badges.ParseBadgeTier(strings.TrimSpace(tierStr))
+		if err != nil {
+			return nil, err
+		}
+		if tier == badges.BadgeTierNone {
+			return nil, fmt.Errorf("requirement %q must ask for Bronze through Legendary", part)
+		}
+		reqs = append(reqs, builds.Requirement{Badge: strings.TrimSpace(name), Tier: tier})
+	}
+	return reqs, nil
+}
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/builds"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// players collects repeated --player flags
+type players []builds.Player
+
+func (p *players) String() string {
+	parts := make([]string, len(*p))
+	for i, pl := range *p {
+		parts[i] = pl.String()
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (p *players) Set(s string) error {
+	pl, err := builds.ParsePlayer(s)
+	if err != nil {
+		return err
+	}
+	*p = append(*p, pl)
+	return nil
+}
+
+// inputs collects repeated --input flags
+type inputs []string
+
+func (in *inputs) String() string {
+	return strings.Join(*in, ", ")
+}
+
+func (in *inputs) Set(s string) error {
+	*in = append(*in, s)
+	return nil
+}
+
+func main() {
+	var lineup players
+	flag.Var(&lineup, "player", "Player as position:height/wingspan/weight, e.g. PG:6-3/6-8/190 (default position Center; repeat once per slot)")
+	var inputFiles inputs
+	flag.Var(&inputFiles, "input", "Scraped builds JSON file, repeatable for several positions (default: Center calculators)")
+	var (
+		weightStep = flag.Int("weight-step", 5, "Weight step in lbs when using the calculators")
+		noSwaps    = flag.Bool("no-swaps", false, "Skip the swap search")
+	)
+	flag.Parse()
+
+	if len(lineup) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: at least one --player is required\n\n")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	var caps []scraper.AttributeCaps
+	for _, path := range inputFiles {
+		loaded, err := scraper.LoadCaps(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading builds: %v\n", err)
+			os.Exit(1)
+		}
+		caps = append(caps, loaded...)
+	}
+	if len(inputFiles) == 0 {
+		caps = builds.CenterCaps(*weightStep)
+	}
+
+	searched := make([]int, len(lineup)) // slot → builds at the player's position
+	for slot, p := range lineup {
+		for i := range caps {
+			if p.Plays(&caps[i]) {
+				searched[slot]++
+			}
+		}
+		if searched[slot] == 0 {
+			fmt.Fprintf(os.Stderr, "Error: no %s builds to evaluate (only Center caps are calculated; use --input)\n", p.Position)
+			os.Exit(1)
+		}
+	}
+
+	calc, err := badges.NewCalculator()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing badge calculator: %v\n", err)
+		os.Exit(1)
+	}
+
+	coverage, err := builds.AnalyzeLineup(calc, caps, lineup)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("Lineup (%d players)\n", len(lineup))
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	for i, p := range lineup {
+		fmt.Printf("  P%d: %s (%d builds)\n", i+1, p, searched[i])
+	}
+
+	printCoverage(coverage)
+
+	if *noSwaps {
+		return
+	}
+	swaps, err := builds.SuggestSwaps(calc, caps, lineup)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	printSwaps(swaps)
+}
+
+// printCoverage prints the badges the lineup covers by category, then duplicates and gaps
+func printCoverage(lc *builds.LineupCoverage) {
+	for _, cat := range lc.Categories {
+		fmt.Printf("\n%s (%d/%d covered):\n", cat.Category, cat.Covered, cat.Total)
+		for _, b := range lc.Badges {
+			if b.Category != cat.Category || b.Best == badges.BadgeTierNone {
+				continue
+			}
+			fmt.Printf("  %-13s %s (%s)\n", b.Best, b.Badge, holders(b))
+		}
+		if len(cat.Gaps) > 0 {
+			fmt.Printf("  ❌ Gaps: %s\n", strings.Join(cat.Gaps, ", "))
+		}
+	}
+
+	fmt.Printf("\nCoverage score: %d\n", lc.Score)
+
+	if dups := lc.Duplicates(); len(dups) > 0 {
+		fmt.Printf("\nDuplicated (%d):\n", len(dups))
+		for _, b := range dups {
+			fmt.Printf("  %s (%s)\n", b.Badge, holders(b))
+		}
+	}
+}
+
+// holders formats the players that have a badge as "P1 Gold, P3 Bronze"
+func holders(b builds.BadgeCoverage) string {
+	var parts []string
+	for _, slot := range b.Holders() {
+		parts = append(parts, fmt.Sprintf("P%d %s", slot+1, b.Tiers[slot]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// printSwaps prints each slot's best improving build
+func printSwaps(swaps []builds.Swap) {
+	fmt.Printf("\n━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("Suggested Swaps (each player searches the builds at their position)\n")
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	if len(swaps) == 0 {
+		fmt.Println("  ✅ No single-player change improves coverage")
+		return
+	}
+	for _, s := range swaps {
+		fmt.Printf("  P%d: %s → %s  (+%d)\n", s.Slot+1, s.From, s.To, s.Gain)
+		if len(s.Gained) > 0 {
+			fmt.Printf("      + %s\n", joinChanges(s.Gained))
+		}
+		if len(s.Lost) > 0 {
+			fmt.Printf("      - %s\n", joinChanges(s.Lost))
+		}
+	}
+}
+
+// joinChanges formats badge changes as a comma-separated list
+func joinChanges(changes []builds.BadgeChange) string {
+	parts := make([]string, len(changes))
+	for i, c := range changes {
+		parts[i] = c.String()
+	}
+	return strings.Join(parts, ", ")
+}
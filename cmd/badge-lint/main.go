@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+)
+
+func main() {
+	var (
+		files  = flag.String("files", "pkg/badges/data/badge_requirements.json", "Comma-separated requirements files (every file must match the first)")
+		strict = flag.Bool("strict", false, "Exit non-zero on warnings too")
+	)
+	flag.Parse()
+
+	paths := strings.Split(*files, ",")
+	issues, err := badges.LintFiles(paths...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error linting: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("Badge requirements lint (%d files)\n", len(paths))
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+
+	errors, warnings := 0, 0
+	for _, issue := range issues {
+		if issue.Severity == badges.LintError {
+			errors++
+			fmt.Printf("❌ %s\n", issue)
+		} else {
+			warnings++
+			fmt.Printf("⚠️  %s\n", issue)
+		}
+	}
+
+	if len(issues) == 0 {
+		fmt.Printf("✅ No issues\n")
+		return
+	}
+	fmt.Printf("\n%d errors, %d warnings\n", errors, warnings)
+
+	if errors > 0 || (*strict && warnings > 0) {
+		os.Exit(1)
+	}
+}
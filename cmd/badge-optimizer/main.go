@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jredh-dev/nba2k26/pkg/attributes"
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/builds"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+func main() {
+	var (
+		targetsStr  = flag.String("targets", "", "Badge wishlist, e.g. \"Posterizer=Gold!,Rebound Chaser=Silver:2\" (:N weights a target, ! makes its tier a hard minimum)")
+		heightStr   = flag.String("height", "", "Current build height (7-0 or 84) to compare against")
+		wingspanStr = flag.String("wingspan", "", "Current build wingspan (7-3 or 87)")
+		weight      = flag.Int("weight", 0, "Current build weight in pounds")
+		top         = flag.Int("top", 10, "Number of builds to show")
+		inputFile   = flag.String("input", "", "Scraped builds JSON file (default: attribute calculators)")
+		weightStep  = flag.Int("weight-step", 5, "Weight step in lbs when using the calculators")
+	)
+	flag.Parse()
+
+	targets, err := parseTargets(*targetsStr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n\n", err)
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	var baseline *attributes.Build
+	if *heightStr != "" || *wingspanStr != "" || *weight != 0 {
+		baseline, err = parseBuild(*heightStr, *wingspanStr, *weight)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	var caps []scraper.AttributeCaps
+	if *inputFile != "" {
+		caps, err = scraper.LoadCaps(*inputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading builds: %v\n", err)
+			os.Exit(1)
+		}
+	} else {
+		caps = builds.CenterCaps(*weightStep)
+	}
+
+	calc, err := badges.NewCalculator()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing badge calculator: %v\n", err)
+		os.Exit(1)
+	}
+
+	candidates, err := builds.Optimize(calc, caps, targets, baseline, *top)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	maxScore := 0.0
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("Badge Wishlist (%d builds searched)\n", len(caps))
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	for _, t := range targets {
+		w := t.Weight
+		if w == 0 {
+			w = 1
+		}
+		maxScore += w
+		if t.Required {
+			fmt.Printf("  %s %s (weight %g, required)\n", t.Tier, t.Badge, w)
+		} else {
+			fmt.Printf("  %s %s (weight %g)\n", t.Tier, t.Badge, w)
+		}
+	}
+	if baseline != nil {
+		fmt.Printf("Compared with: %s\n", baseline)
+	}
+	fmt.Println()
+
+	if len(candidates) == 0 {
+		fmt.Println("❌ No build meets every required target")
+		return
+	}
+	for i, c := range candidates {
+		fmt.Printf("%2d. %s  score %.2f/%g\n", i+1, c.Build, c.Score, maxScore)
+		for _, t := range targets {
+			tier := tierOf(c.Tiers, t.Badge, calc)
+			mark := "✅"
+			if tier < t.Tier {
+				mark = "❌"
+			}
+			fmt.Printf("      %s %s: %s\n", mark, t.Badge, tier)
+		}
+		if len(c.Gained) > 0 {
+			fmt.Printf("      + %s\n", joinChanges(c.Gained))
+		}
+		if len(c.Lost) > 0 {
+			fmt.Printf("      - %s\n", joinChanges(c.Lost))
+		}
+	}
+}
+
+// tierOf looks up a target's tier by the badge's display name
+func tierOf(tiers map[string]badges.BadgeTier, name string, calc *badges.Calculator) badges.BadgeTier {
+	if b, err := calc.Badge(name); err == nil {
+		return tiers[b.Name]
+	}
+	return badges.BadgeTierNone
+}
+
+// joinChanges formats badge changes as a comma-separated list
+func joinChanges(changes []builds.BadgeChange) string {
+	parts := make([]string, len(changes))
+	for i, c := range changes {
+		parts[i] = c.String()
+	}
+	return strings.Join(parts, ", ")
+}
+
+// parseTargets parses "Badge=Tier[:weight],..." into optimizer targets
+func parseTargets(s string) ([]builds.Target, error) {
+	if s == "" {
+		return nil, fmt.Errorf("--targets is required")
+	}
+
+	var targets []builds.Target
+	for _, part := range strings.Split(s, ",") {
+		name, rest, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid target %q (use Badge=Tier, Badge=Tier!, or Badge=Tier:weight)", part)
+		}
+		tierStr, weightStr, hasWeight := strings.Cut(rest, ":")
+		tierStr, required := strings.CutSuffix(strings.TrimSpace(tierStr), "!")
+
+		tier, err := badges.ParseBadgeTier(tierStr)
+		if err != nil {
+			return nil, err
+		}
+		if tier == badges.BadgeTierNone {
+			return nil, fmt.Errorf("invalid target %q (the tier must be Bronze through Legendary)", part)
+		}
+		t := builds.Target{Badge: strings.TrimSpace(name), Tier: tier, Required: required}
+		if hasWeight {
+			t.Weight, err = strconv.ParseFloat(strings.TrimSpace(weightStr), 64)
+			if err != nil || t.Weight <= 0 {
+				return nil, fmt.Errorf("invalid weight %q in %q", weightStr, part)
+			}
+		}
+		targets = append(targets, t)
+	}
+	return targets, nil
+}
+
+// parseBuild parses the current build from --height, --wingspan, and --weight
+func parseBuild(heightStr, wingspanStr string, weight int) (*attributes.Build, error) {
+	if heightStr == "" || wingspanStr == "" || weight == 0 {
+		return nil, fmt.Errorf("--height, --wingspan, and --weight are all needed to compare against a build")
+	}
+	height, err := attributes.ParseInches(heightStr)
+	if err != nil {
+		return nil, err
+	}
+	wingspan, err := attributes.ParseInches(wingspanStr)
+	if err != nil {
+		return nil, err
+	}
+	return &attributes.Build{Height: height, Wingspan: wingspan, Weight: weight}, nil
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/builds"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+func main() {
+	var (
+		badge      = flag.String("badge", "", "Badge to look up (e.g., Posterizer)")
+		tierStr    = flag.String("tier", "", "Tier to show per-height ranges for (Bronze, Silver, Gold, HoF, Legendary; default: highest reachable)")
+		inputFile  = flag.String("input", "", "Scraped builds JSON file (default: attribute calculators)")
+		weightStep = flag.Int("weight-step", 5, "Weight step in lbs when using the calculators")
+	)
+	flag.Parse()
+
+	if *badge == "" {
+		fmt.Fprintf(os.Stderr, "Error: --badge is required\n\n")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	source := fmt.Sprintf("calculators, %d lb steps", *weightStep)
+	var caps []scraper.AttributeCaps
+	if *inputFile != "" {
+		var err error
+		caps, err = scraper.LoadCaps(*inputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading builds: %v\n", err)
+			os.Exit(1)
+		}
+		source = *inputFile
+	} else {
+		caps = builds.CenterCaps(*weightStep)
+	}
+
+	calc, err := badges.NewCalculator()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing badge calculator: %v\n", err)
+		os.Exit(1)
+	}
+
+	reach, err := builds.ReachBadge(calc, caps, *badge)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("%s (%d builds, %s)\n", reach.Badge, reach.Total, source)
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("%-14s %7s   %s\n", "Tier", "Builds", "Best build")
+
+	highest := badges.BadgeTierNone
+	for t := badges.BadgeTierBronze; t <= badges.BadgeTierLegendary; t++ {
+		tr := reach.Tiers[t]
+		if tr.Best == nil {
+			fmt.Printf("%-14s %7d   -\n", t, 0)
+			continue
+		}
+		highest = t
+		fmt.Printf("%-14s %7d   %s (%d requirement points)\n", t, tr.Builds, tr.Best, tr.BestPoints)
+	}
+	fmt.Println()
+
+	tier := highest
+	if *tierStr != "" {
+		tier, err = badges.ParseBadgeTier(*tierStr)
+		if err == nil && tier == badges.BadgeTierNone {
+			err = fmt.Errorf("--tier must be Bronze through Legendary")
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	if tier == badges.BadgeTierNone {
+		fmt.Printf("❌ No build reaches %s.\n", reach.Badge)
+		return
+	}
+
+	tr := reach.Tiers[tier]
+	fmt.Printf("%s at %s: %d builds\n", reach.Badge, tier, tr.Builds)
+	if len(tr.Heights) == 0 {
+		fmt.Printf("❌ No build reaches %s.\n", tier)
+		return
+	}
+	for _, h := range tr.Heights {
+		fmt.Printf("  %s\n", h)
+	}
+}
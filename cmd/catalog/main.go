@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/jredh-dev/nba2k26/pkg/archetypes"
+	"github.com/jredh-dev/nba2k26/pkg/attributes"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// catalogEntry is a build annotated with its archetype
+type catalogEntry struct {
+	scraper.AttributeCaps
+	Archetype archetypes.Classification `json:"archetype"`
+}
+
+func main() {
+	var (
+		inputFile  = flag.String("input", "data/Center_caps.json", "Scraped builds JSON file")
+		outputFile = flag.String("output", "", "Output file (default: stdout)")
+		format     = flag.String("format", "json", "Output format (json, csv)")
+		computed   = flag.Bool("computed", false, "Catalog every legal Center build from the calculators instead of scraped data")
+		weightStep = flag.Int("weight-step", 5, "Weight step in lbs for --computed")
+	)
+	flag.Parse()
+
+	var caps []scraper.AttributeCaps
+	if *computed {
+		caps = attributes.CenterCapTable().EvaluateAll(*weightStep)
+	} else {
+		var err error
+		caps, err = scraper.LoadCaps(*inputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading builds: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	classifier, err := archetypes.NewClassifier()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading archetype rules: %v\n", err)
+		os.Exit(1)
+	}
+
+	entries := make([]catalogEntry, 0, len(caps))
+	for i := range caps {
+		entries = append(entries, catalogEntry{
+			AttributeCaps: caps[i],
+			Archetype:     classifier.Classify(&caps[i]),
+		})
+	}
+
+	out := os.Stdout
+	if *outputFile != "" {
+		f, err := os.Create(*outputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating output file: %v\n", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	switch *format {
+	case "json":
+		err = writeJSON(out, entries)
+	case "csv":
+		err = writeCSV(out, entries)
+	default:
+		err = fmt.Errorf("unknown format %q (use json or csv)", *format)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing catalog: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// writeJSON writes the catalog as an indented JSON array
+func writeJSON(w io.Writer, entries []catalogEntry) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(entries)
+}
+
+// writeCSV writes one row per build with every attribute and the archetype
+func writeCSV(w io.Writer, entries []catalogEntry) error {
+	cw := csv.NewWriter(w)
+
+	header := []string{"position", "height", "wingspan", "weight"}
+	header = append(header, scraper.AttributeNames...)
+	header = append(header, "archetype", "archetype_drivers")
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for i := range entries {
+		e := &entries[i]
+		row := []string{
+			e.Position,
+			strconv.Itoa(e.Height),
+			strconv.Itoa(e.Wingspan),
+			strconv.Itoa(e.Weight),
+		}
+		for _, name := range scraper.AttributeNames {
+			value, _ := e.Get(name)
+			row = append(row, strconv.Itoa(value))
+		}
+		row = append(row, e.Archetype.Archetype, e.Archetype.Explanation())
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jredh-dev/nba2k26/pkg/attributes"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+func main() {
+	var (
+		inputFile = flag.String("input", "data/Center_caps.json", "Scraped builds JSON file")
+		source    = flag.String("source", "all", "What to check (calculators, data, all)")
+	)
+	flag.Parse()
+
+	if *source != "calculators" && *source != "data" && *source != "all" {
+		fmt.Fprintf(os.Stderr, "Unknown source %q (use calculators, data, or all)\n", *source)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Checking %d invariants\n", len(attributes.CenterInvariants))
+	for _, inv := range attributes.CenterInvariants {
+		fmt.Printf("  - %s\n", inv)
+	}
+	unmodeled := attributes.InvariantCoverage(attributes.CenterInvariants, nil).Unmodeled
+	fmt.Printf("\n⚠️  Not checked (%d attributes have no invariants): %s\n\n", len(unmodeled), strings.Join(unmodeled, ", "))
+
+	total := 0
+
+	if *source != "data" {
+		caps := attributes.CenterCapTable().EvaluateAll(1)
+		violations, err := attributes.CheckInvariants(attributes.CenterInvariants, caps)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error checking calculators: %v\n", err)
+			os.Exit(1)
+		}
+		report("Calculators (every legal build, 1 lb steps)", violations, attributes.InvariantCoverage(attributes.CenterInvariants, caps))
+		total += len(violations)
+	}
+
+	if *source != "calculators" {
+		caps, err := scraper.LoadCaps(*inputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading builds: %v\n", err)
+			os.Exit(1)
+		}
+
+		violations, err := attributes.CheckInvariants(attributes.CenterInvariants, caps)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error checking data: %v\n", err)
+			os.Exit(1)
+		}
+		report(fmt.Sprintf("Scraped data (%s, %d builds)", *inputFile, len(caps)), violations, attributes.InvariantCoverage(attributes.CenterInvariants, caps))
+		total += len(violations)
+	}
+
+	if total > 0 {
+		os.Exit(1)
+	}
+}
+
+// report prints the violations found in one source and the builds left unchecked
+func report(title string, violations []attributes.Violation, coverage attributes.Coverage) {
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("%s\n", title)
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+
+	for _, name := range scraper.AttributeNames {
+		if n := coverage.Skipped[name]; n > 0 {
+			fmt.Printf("⚠️  %s: %d builds with cap 0 (not modeled) skipped\n", name, n)
+		}
+	}
+
+	if len(violations) == 0 {
+		fmt.Printf("✅ No violations\n\n")
+		return
+	}
+
+	for _, v := range violations {
+		fmt.Printf("❌ %s\n", v)
+	}
+	fmt.Printf("\n%d violations\n\n", len(violations))
+}
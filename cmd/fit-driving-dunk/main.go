@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/jredh-dev/nba2k26/pkg/attributes"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+func main() {
+	var (
+		inputFile = flag.String("input", "data/Center_caps.json", "Scraped builds JSON file")
+		showAll   = flag.Bool("all", false, "List every mismatch (default: first 20)")
+	)
+	flag.Parse()
+
+	caps, err := scraper.LoadCaps(*inputFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading builds: %v\n", err)
+		os.Exit(1)
+	}
+
+	// How well does the current calculator do?
+	current := attributes.CompareDrivingDunk(caps)
+	printMismatches("Current DrivingDunk", len(caps), current, *showAll)
+
+	// Fit the wingspan table and weight thresholds from the grid
+	fit, err := attributes.FitDrivingDunk(caps)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fitting Driving Dunk: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("// Fitted from %d builds; paste into pkg/attributes/center.go\n", fit.Builds)
+	fmt.Print(fit.GoSource())
+}
+
+// printMismatches prints a model's match rate and the builds it misses
+func printMismatches(title string, total int, mismatches []attributes.FitMismatch, showAll bool) {
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("%s: %d/%d builds match\n", title, total-len(mismatches), total)
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+
+	for i, m := range mismatches {
+		if i == 20 && !showAll {
+			fmt.Printf("... %d more (use --all)\n", len(mismatches)-i)
+			break
+		}
+		fmt.Printf("❌ %s\n", m)
+	}
+	fmt.Println()
+}
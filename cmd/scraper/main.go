@@ -10,17 +10,41 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/jredh-dev/nba2k26/pkg/badges"
 	"github.com/jredh-dev/nba2k26/pkg/scraper"
 )
 
+const (
+	// badgeRequirementsPath is the canonical badge requirement sheet embedded by pkg/badges
+	badgeRequirementsPath = "pkg/badges/data/badge_requirements.json"
+
+	// scrapedBadgesPath is where --badges writes by default (gitignored scratch output)
+	// The badges endpoint is unconfirmed, so a scrape never replaces the
+	// canonical sheet unless --output names it.
+	scrapedBadgesPath = "data/badge_requirements.scraped.json"
+)
+
 func main() {
 	var (
 		position   = flag.String("position", "Center", "Position to scrape (Center, Point Guard, etc.)")
 		outputFile = flag.String("output", "", "Output JSON file (default: data/<position>_caps.json)")
 		sample     = flag.Bool("sample", false, "Run small sample scrape for testing")
+		badgeSheet = flag.Bool("badges", false, "Scrape the badge requirement sheet instead of attribute caps (default output: "+scrapedBadgesPath+")")
+		badgesURL  = flag.String("badges-url", scraper.NBA2KLabBadgesURL, "Badge requirement sheet endpoint")
 	)
 	flag.Parse()
 
+	if *badgeSheet {
+		if *outputFile == "" {
+			*outputFile = scrapedBadgesPath
+		}
+		if err := scrapeBadges(scraper.NewClient(scraper.WithBadgesURL(*badgesURL)), *outputFile); err != nil {
+			fmt.Fprintf(os.Stderr, "Error scraping badges: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if *outputFile == "" {
 		// Default output path
 		*outputFile = filepath.Join("data", fmt.Sprintf("%s_caps.json", *position))
@@ -62,6 +86,54 @@ func main() {
 	fmt.Printf("📁 Saved to: %s\n", *outputFile)
 }
 
+// scrapeBadges fetches the badge requirement sheet, checks it loads, and writes it
+// Nothing is written if the sheet has lint errors.
+func scrapeBadges(client *scraper.Client, path string) error {
+	fmt.Println("Scraping badge requirements...")
+	reqs, err := client.FetchBadgeRequirements()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(reqs)
+	if err != nil {
+		return fmt.Errorf("failed to marshal badge requirements: %w", err)
+	}
+	issues, err := badges.Lint(data)
+	if err != nil {
+		return err
+	}
+	lintErrors := 0
+	for _, issue := range issues {
+		if issue.Severity == badges.LintError {
+			lintErrors++
+			fmt.Printf("  ❌ %s\n", issue)
+		}
+	}
+	if lintErrors > 0 {
+		return fmt.Errorf("%d lint errors in the scraped sheet; %s was not changed", lintErrors, path)
+	}
+
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create output directory: %w", err)
+		}
+	}
+	if err := scraper.WriteBadgeRequirements(path, reqs); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n✅ Successfully scraped %d badge requirement rows\n", len(reqs))
+	fmt.Printf("📁 Saved to: %s\n", path)
+	if path == badgeRequirementsPath {
+		fmt.Println("Run `go generate ./pkg/badges` to refresh the badge ID constants")
+	} else {
+		fmt.Printf("⚠️  The badges endpoint is unconfirmed; compare with `go run ./cmd/badge-diff --new %s`\n", path)
+		fmt.Printf("   before copying it over %s\n", badgeRequirementsPath)
+	}
+	return nil
+}
+
 // scrapeCenters scrapes all valid Center builds using physical bounds
 func scrapeCenters(client *scraper.Client) ([]*scraper.AttributeCaps, error) {
 	// Define Center bounds (heights 79-88 inches / 6'7" to 7'4")
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+// Package archetypes labels builds (e.g., "Paint Beast", "Stretch Big") from their
+// attribute profile using an ordered rule set loaded from data/archetypes.json.
+package archetypes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// Condition bounds a single attribute
+type Condition struct {
+	// Attribute is the attribute name (see scraper.AttributeNames)
+	Attribute string `json:"attribute"`
+	// Min is the minimum value (0 if no lower bound)
+	Min int `json:"min,omitempty"`
+	// Max is the maximum value (0 if no upper bound)
+	Max int `json:"max,omitempty"`
+}
+
+// Rule assigns an archetype when all of its conditions hold
+type Rule struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Conditions  []Condition `json:"conditions"`
+}
+
+// Driver is an attribute that satisfied one of the winning rule's conditions
+type Driver struct {
+	Attribute string `json:"attribute"`
+	Value     int    `json:"value"`
+	Min       int    `json:"min,omitempty"`
+	Max       int    `json:"max,omitempty"`
+}
+
+// Classification is the archetype assigned to a build and why
+type Classification struct {
+	Archetype   string   `json:"archetype"`
+	Description string   `json:"description"`
+	Drivers     []Driver `json:"drivers"`
+}
+
+// Explanation describes which attributes drove the label
+// e.g., "Block 95 ≥ 90, Interior Defense 92 ≥ 90"
+func (c Classification) Explanation() string {
+	if len(c.Drivers) == 0 {
+		return "no rule matched; default archetype"
+	}
+
+	parts := make([]string, 0, len(c.Drivers))
+	for _, d := range c.Drivers {
+		var bounds []string
+		if d.Min > 0 {
+			bounds = append(bounds, fmt.Sprintf("≥ %d", d.Min))
+		}
+		if d.Max > 0 {
+			bounds = append(bounds, fmt.Sprintf("≤ %d", d.Max))
+		}
+		parts = append(parts, fmt.Sprintf("%s %d %s", d.Attribute, d.Value, strings.Join(bounds, ", ")))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Classifier assigns archetypes from an ordered rule set
+type Classifier struct {
+	rules []Rule
+}
+
+// NewClassifier creates a classifier from the embedded rule set
+func NewClassifier() (*Classifier, error) {
+	rules, err := LoadRules()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Classifier{rules: rules}, nil
+}
+
+// Classify returns the first archetype whose conditions all hold for attrs
+// The rule set ends with a catch-all, so a label is always assigned
+func (c *Classifier) Classify(attrs *scraper.AttributeCaps) Classification {
+	for _, rule := range c.rules {
+		drivers, ok := matchRule(rule, attrs)
+		if !ok {
+			continue
+		}
+
+		return Classification{
+			Archetype:   rule.Name,
+			Description: rule.Description,
+			Drivers:     drivers,
+		}
+	}
+
+	// Unreachable with a validated rule set
+	return Classification{}
+}
+
+// Rules returns the rule set in evaluation order
+func (c *Classifier) Rules() []Rule {
+	return c.rules
+}
+
+// matchRule checks every condition of a rule, returning the drivers on success
+func matchRule(rule Rule, attrs *scraper.AttributeCaps) ([]Driver, bool) {
+	drivers := make([]Driver, 0, len(rule.Conditions))
+
+	for _, cond := range rule.Conditions {
+		value, _ := attrs.Get(cond.Attribute)
+		if cond.Min > 0 && value < cond.Min {
+			return nil, false
+		}
+		if cond.Max > 0 && value > cond.Max {
+			return nil, false
+		}
+
+		drivers = append(drivers, Driver{
+			Attribute: cond.Attribute,
+			Value:     value,
+			Min:       cond.Min,
+			Max:       cond.Max,
+		})
+	}
+
+	return drivers, true
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+package archetypes_test
+
+import (
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/archetypes"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLoadRules verifies the embedded rule set parses and ends with a catch-all
+func TestLoadRules(t *testing.T) {
+	rules, err := archetypes.LoadRules()
+	require.NoError(t, err)
+	require.NotEmpty(t, rules)
+	assert.Empty(t, rules[len(rules)-1].Conditions)
+}
+
+// TestClassify tests archetype assignment and explanations
+func TestClassify(t *testing.T) {
+	classifier, err := archetypes.NewClassifier()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		attrs     scraper.AttributeCaps
+		archetype string
+		drivers   []string
+	}{
+		{
+			name: "two-way rim protector beats paint beast",
+			attrs: scraper.AttributeCaps{
+				StandingDunk: 95, PostControl: 90, Strength: 90,
+				Block: 95, InteriorDefense: 92,
+			},
+			archetype: "Two-Way Rim Protector",
+			drivers:   []string{"Block", "Interior Defense", "Standing Dunk"},
+		},
+		{
+			name: "paint beast without rim protection",
+			attrs: scraper.AttributeCaps{
+				StandingDunk: 95, PostControl: 90, Strength: 90,
+				Block: 70, InteriorDefense: 70,
+			},
+			archetype: "Paint Beast",
+			drivers:   []string{"Standing Dunk", "Post Control", "Strength"},
+		},
+		{
+			name: "stretch big",
+			attrs: scraper.AttributeCaps{
+				ThreePointShot: 84, MidRangeShot: 80, DefensiveRebound: 80,
+			},
+			archetype: "Stretch Big",
+			drivers:   []string{"Three-Point Shot", "Defensive Rebound"},
+		},
+		{
+			name:      "nothing stands out",
+			attrs:     scraper.AttributeCaps{CloseShot: 70, Block: 70},
+			archetype: "Balanced",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := classifier.Classify(&tt.attrs)
+			assert.Equal(t, tt.archetype, got.Archetype)
+
+			var names []string
+			for _, d := range got.Drivers {
+				names = append(names, d.Attribute)
+			}
+			assert.Equal(t, tt.drivers, names)
+			assert.NotEmpty(t, got.Explanation())
+		})
+	}
+}
+
+// TestExplanation tests the human-readable driver summary
+func TestExplanation(t *testing.T) {
+	c := archetypes.Classification{
+		Archetype: "Rim Protector",
+		Drivers: []archetypes.Driver{
+			{Attribute: "Block", Value: 95, Min: 90},
+			{Attribute: "Interior Defense", Value: 88, Min: 85},
+		},
+	}
+	assert.Equal(t, "Block 95 ≥ 90, Interior Defense 88 ≥ 85", c.Explanation())
+}
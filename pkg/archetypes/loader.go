@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+package archetypes
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+//go:embed data/archetypes.json
+var archetypeDataFS embed.FS
+
+// LoadRules loads and validates the archetype rule set from embedded JSON
+func LoadRules() ([]Rule, error) {
+	data, err := archetypeDataFS.ReadFile("data/archetypes.json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archetype rules: %w", err)
+	}
+
+	var rules []Rule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse archetype rules: %w", err)
+	}
+
+	if err := validateRules(rules); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// validateRules checks attribute names and requires a catch-all rule at the end
+func validateRules(rules []Rule) error {
+	if len(rules) == 0 {
+		return fmt.Errorf("archetype rule set is empty")
+	}
+
+	var probe scraper.AttributeCaps
+	for _, rule := range rules {
+		if rule.Name == "" {
+			return fmt.Errorf("archetype rule missing name")
+		}
+		for _, cond := range rule.Conditions {
+			if _, ok := probe.Get(cond.Attribute); !ok {
+				return fmt.Errorf("archetype %q: unknown attribute %q", rule.Name, cond.Attribute)
+			}
+			if cond.Min == 0 && cond.Max == 0 {
+				return fmt.Errorf("archetype %q: condition on %q has no bounds", rule.Name, cond.Attribute)
+			}
+		}
+	}
+
+	if last := rules[len(rules)-1]; len(last.Conditions) != 0 {
+		return fmt.Errorf("last archetype rule %q must have no conditions (catch-all)", last.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+package attributes
+
+import (
+	"fmt"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// CapFunc calculates an attribute cap from height, weight, and wingspan
+type CapFunc func(heightInches, weightLbs, wingspanInches int) int
+
+// CenterCalculators maps attribute names (see scraper.AttributeNames) to Center cap functions
+var CenterCalculators = map[string]CapFunc{
+	"Close Shot":        CloseShot,
+	"Driving Layup":     DrivingLayup,
+	"Driving Dunk":      DrivingDunk,
+	"Standing Dunk":     StandingDunk,
+	"Post Control":      PostControl,
+	"Mid-Range Shot":    MidRangeShot,
+	"Three-Point Shot":  ThreePointShot,
+	"Free Throw":        FreeThrow,
+	"Pass Accuracy":     PassAccuracy,
+	"Ball Handle":       BallHandle,
+	"Speed With Ball":   SpeedWithBall,
+	"Interior Defense":  InteriorDefense,
+	"Perimeter Defense": PerimeterDefense,
+	"Steal":             Steal,
+	"Block":             Block,
+	"Offensive Rebound": OffensiveRebound,
+	"Defensive Rebound": DefensiveRebound,
+	"Speed":             Speed,
+	"Agility":           Agility,
+	"Strength":          Strength,
+	"Vertical":          Vertical,
+}
+
+// Build is a combination of physical measurements
+type Build struct {
+	Height   int `json:"height"`   // inches
+	Wingspan int `json:"wingspan"` // inches
+	Weight   int `json:"weight"`   // lbs
+}
+
+// String returns the build in the format 7'0" / 7'3" / 260 lbs
+func (b Build) String() string {
+	return fmt.Sprintf("%s / %s / %d lbs", InchesToLength(b.Height), InchesToLength(b.Wingspan), b.Weight)
+}
+
+// CenterCaps calculates every attribute cap for a Center build
+func CenterCaps(heightInches, weightLbs, wingspanInches int) *scraper.AttributeCaps {
+	return &scraper.AttributeCaps{
+		Position:         "Center",
+		Height:           heightInches,
+		Wingspan:         wingspanInches,
+		Weight:           weightLbs,
+		CloseShot:        CloseShot(heightInches, weightLbs, wingspanInches),
+		DrivingLayup:     DrivingLayup(heightInches, weightLbs, wingspanInches),
+		DrivingDunk:      DrivingDunk(heightInches, weightLbs, wingspanInches),
+		StandingDunk:     StandingDunk(heightInches, weightLbs, wingspanInches),
+		PostControl:      PostControl(heightInches, weightLbs, wingspanInches),
+		MidRangeShot:     MidRangeShot(heightInches, weightLbs, wingspanInches),
+		ThreePointShot:   ThreePointShot(heightInches, weightLbs, wingspanInches),
+		FreeThrow:        FreeThrow(heightInches, weightLbs, wingspanInches),
+		PassAccuracy:     PassAccuracy(heightInches, weightLbs, wingspanInches),
+		BallHandle:       BallHandle(heightInches, weightLbs, wingspanInches),
+		SpeedWithBall:    SpeedWithBall(heightInches, weightLbs, wingspanInches),
+		InteriorDefense:  InteriorDefense(heightInches, weightLbs, wingspanInches),
+		PerimeterDefense: PerimeterDefense(heightInches, weightLbs, wingspanInches),
+		Steal:            Steal(heightInches, weightLbs, wingspanInches),
+		Block:            Block(heightInches, weightLbs, wingspanInches),
+		OffensiveRebound: OffensiveRebound(heightInches, weightLbs, wingspanInches),
+		DefensiveRebound: DefensiveRebound(heightInches, weightLbs, wingspanInches),
+		Speed:            Speed(heightInches, weightLbs, wingspanInches),
+		Agility:          Agility(heightInches, weightLbs, wingspanInches),
+		Strength:         Strength(heightInches, weightLbs, wingspanInches),
+		Vertical:         Vertical(heightInches, weightLbs, wingspanInches),
+	}
+}
+
+// centerBoundsForInches returns the bounds for a height in inches, or nil if invalid
+func centerBoundsForInches(heightInches int) *PhysicalBounds {
+	return GetBounds(InchesToLength(heightInches))
+}
+
+// IsLegalCenterBuild checks a build against CenterBounds
+func IsLegalCenterBuild(b Build) bool {
+	bounds := centerBoundsForInches(b.Height)
+	if bounds == nil {
+		return false
+	}
+
+	if b.Weight < bounds.MinWeight || b.Weight > bounds.MaxWeight {
+		return false
+	}
+
+	return b.Wingspan >= MustLengthToInches(bounds.MinWingspan) &&
+		b.Wingspan <= MustLengthToInches(bounds.MaxWingspan)
+}
+
+// LegalCenterBuilds enumerates every legal Center build ordered by height, wingspan, weight
+// Weights step from each height's minimum by weightStep lbs (1 for every weight, 5 to match the scraper)
+func LegalCenterBuilds(weightStep int) []Build {
+	if weightStep < 1 {
+		weightStep = 1
+	}
+
+	var builds []Build
+	minHeight := MustLengthToInches(CENTER_MIN_HEIGHT)
+	maxHeight := MustLengthToInches(CENTER_MAX_HEIGHT)
+
+	for height := minHeight; height <= maxHeight; height++ {
+		bounds := centerBoundsForInches(height)
+		if bounds == nil {
+			continue
+		}
+
+		minWingspan := MustLengthToInches(bounds.MinWingspan)
+		maxWingspan := MustLengthToInches(bounds.MaxWingspan)
+
+		for wingspan := minWingspan; wingspan <= maxWingspan; wingspan++ {
+			for weight := bounds.MinWeight; weight <= bounds.MaxWeight; weight += weightStep {
+				builds = append(builds, Build{Height: height, Wingspan: wingspan, Weight: weight})
+			}
+		}
+	}
+
+	return builds
+}
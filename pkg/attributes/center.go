@@ -44,6 +44,85 @@ func PassAccuracy(heightInches, weightLbs, wingspanInches int) int {
 	return 99
 }
 
+// weightThreshold caps an attribute at value for weights up to maxWeight
+type weightThreshold struct {
+	maxWeight int
+	value     int
+}
+
+// drivingLayupTable is the Driving Layup lookup table generated from scraped data
+// Heights 6'7"-6'10" are weight-independent
+// Heights 6'11"+ have weight-dependent penalties
+// Format: if weight <= maxWeight, return value (check in order)
+var drivingLayupTable = map[int][]weightThreshold{
+	79: {{99999, 99}}, // 6'7": always 99
+	80: {{99999, 99}}, // 6'8": always 99
+	81: {{99999, 98}}, // 6'9": always 98
+	82: {{99999, 96}}, // 6'10": always 96
+	83: { // 6'11": 93-94 range
+		{250, 94},
+		{99999, 93},
+	},
+	84: { // 7'0": 90-93 range
+		{225, 93},
+		{240, 92},
+		{260, 91},
+		{99999, 90},
+	},
+	85: { // 7'1": 79-86 range
+		{225, 86},   // 220-225 = 86
+		{230, 85},   // 226-230 = 85
+		{240, 84},   // 231-240 = 84
+		{245, 83},   // 241-245 = 83
+		{260, 82},   // 246-260 = 82
+		{270, 80},   // 261-270 = 80-81 (265=80, 260=81 in data, use 80)
+		{99999, 79}, // 271+ = 79
+	},
+	86: { // 7'2": 73-84 range
+		{220, 84},
+		{225, 83},
+		{230, 82},
+		{235, 81},
+		{245, 80}, // 236-245 = 80
+		{250, 78},
+		{255, 77},
+		{260, 76},
+		{265, 75},
+		{275, 74}, // 266-275 = 74
+		{99999, 73},
+	},
+	87: { // 7'3": 64-80 range
+		{230, 80},
+		{235, 78},
+		{240, 77},
+		{245, 76},
+		{250, 75},
+		{255, 73},
+		{260, 72},
+		{265, 71},
+		{270, 70},
+		{275, 68},
+		{280, 67},
+		{285, 66},
+		{99999, 64},
+	},
+	88: { // 7'4": 62-77 range
+		{230, 77},
+		{235, 76},
+		{240, 74},
+		{245, 73},
+		{250, 72},
+		{255, 71},
+		{260, 70},
+		{265, 68},
+		{270, 67},
+		{275, 66},
+		{280, 65},
+		{285, 64},
+		{99999, 62},
+	},
+}
+
 // DrivingLayup calculates the Driving Layup attribute cap for a Center.
 // Testing notes:
 // - At minimum height (79" / 6'7"): cap is 99 (weight doesn't matter)
@@ -52,87 +131,7 @@ func PassAccuracy(heightInches, weightLbs, wingspanInches int) int {
 // - Wingspan does not affect this attribute
 // - Data-driven implementation based on NBA2KLab API scraped data (903 builds)
 func DrivingLayup(heightInches, weightLbs, _ int) int {
-	// Lookup table generated from scraped data
-	// Heights 6'7"-6'10" are weight-independent
-	// Heights 6'11"+ have weight-dependent penalties
-
-	type weightThreshold struct {
-		maxWeight int
-		value     int
-	}
-
-	// Weight-dependent heights use threshold tables
-	// Format: if weight <= maxWeight, return value (check in order)
-	lookupTable := map[int][]weightThreshold{
-		79: {{99999, 99}}, // 6'7": always 99
-		80: {{99999, 99}}, // 6'8": always 99
-		81: {{99999, 98}}, // 6'9": always 98
-		82: {{99999, 96}}, // 6'10": always 96
-		83: { // 6'11": 93-94 range
-			{250, 94},
-			{99999, 93},
-		},
-		84: { // 7'0": 90-93 range
-			{225, 93},
-			{240, 92},
-			{260, 91},
-			{99999, 90},
-		},
-		85: { // 7'1": 79-86 range
-			{225, 86},   // 220-225 = 86
-			{230, 85},   // 226-230 = 85
-			{240, 84},   // 231-240 = 84
-			{245, 83},   // 241-245 = 83
-			{260, 82},   // 246-260 = 82
-			{270, 80},   // 261-270 = 80-81 (265=80, 260=81 in data, use 80)
-			{99999, 79}, // 271+ = 79
-		},
-		86: { // 7'2": 73-84 range
-			{220, 84},
-			{225, 83},
-			{230, 82},
-			{235, 81},
-			{245, 80}, // 236-245 = 80
-			{250, 78},
-			{255, 77},
-			{260, 76},
-			{265, 75},
-			{275, 74}, // 266-275 = 74
-			{99999, 73},
-		},
-		87: { // 7'3": 64-80 range
-			{230, 80},
-			{235, 78},
-			{240, 77},
-			{245, 76},
-			{250, 75},
-			{255, 73},
-			{260, 72},
-			{265, 71},
-			{270, 70},
-			{275, 68},
-			{280, 67},
-			{285, 66},
-			{99999, 64},
-		},
-		88: { // 7'4": 62-77 range
-			{230, 77},
-			{235, 76},
-			{240, 74},
-			{245, 73},
-			{250, 72},
-			{255, 71},
-			{260, 70},
-			{265, 68},
-			{270, 67},
-			{275, 66},
-			{280, 65},
-			{285, 64},
-			{99999, 62},
-		},
-	}
-
-	thresholds, exists := lookupTable[heightInches]
+	thresholds, exists := drivingLayupTable[heightInches]
 	if !exists {
 		return 0 // Invalid height
 	}
@@ -147,197 +146,60 @@ func DrivingLayup(heightInches, weightLbs, _ int) int {
 	return 0 // Should never reach here if table is correct
 }
 
-// DrivingDunk calculates the Driving Dunk attribute cap for a Center.
-// NOTE: Weight also affects this attribute - current implementation uses baseline weight.
-// TODO: Implement weight modifiers (additive system: height_base + wingspan_modifier + weight_modifier)
-func DrivingDunk(heightInches, weightLbs, wingspanInches int) int {
-	// Current implementation: wingspan variations at baseline weight for each height
-	switch heightInches {
-	case MustLengthToInches("6'7"): // 79"
-		switch wingspanInches {
-		case MustLengthToInches("6'7"):
-			return 95
-		case MustLengthToInches("6'8"):
-			return 97
-		case MustLengthToInches("6'9"):
-			return 98
-		case MustLengthToInches("7'1"):
-			return 99
-		}
-	case MustLengthToInches("6'8"): // 80"
-		switch wingspanInches {
-		case MustLengthToInches("6'8"):
-			return 94
-		case MustLengthToInches("6'9"):
-			return 95
-		case MustLengthToInches("6'10"):
-			return 96
-		case MustLengthToInches("6'11"):
-			return 98
-		case MustLengthToInches("7'0"):
-			return 99
-		case MustLengthToInches("7'2"):
-			return 99
-		}
-	case MustLengthToInches("6'9"): // 81"
-		switch wingspanInches {
-		case MustLengthToInches("6'9"):
-			return 92
-		case MustLengthToInches("6'10"):
-			return 93
-		case MustLengthToInches("6'11"):
-			return 94
-		case MustLengthToInches("7'0"):
-			return 95
-		case MustLengthToInches("7'1"):
-			return 96
-		case MustLengthToInches("7'2"):
-			return 98
-		case MustLengthToInches("7'3"):
-			return 99
-		}
-	case MustLengthToInches("6'10"): // 82"
-		switch wingspanInches {
-		case MustLengthToInches("6'10"):
-			return 90
-		case MustLengthToInches("6'11"):
-			return 91
-		case MustLengthToInches("7'0"):
-			return 92
-		case MustLengthToInches("7'1"):
-			return 93
-		case MustLengthToInches("7'2"):
-			return 94
-		case MustLengthToInches("7'3"):
-			return 95
-		case MustLengthToInches("7'4"):
-			return 96
-		}
-	case MustLengthToInches("6'11"): // 83"
-		switch wingspanInches {
-		case MustLengthToInches("6'11"):
-			switch {
-			case weightLbs <= 290:
-				return 85
-			case weightLbs <= 268:
-				return 86
-			case weightLbs <= 225:
-				return 87
-			}
-		case MustLengthToInches("7'0"):
-			switch {
-			case weightLbs <= 290:
-				return 86
-			case weightLbs <= 271:
-				return 87
-			case weightLbs <= 229:
-				return 88
-			}
-		case MustLengthToInches("7'1"):
-			return 88
-		case MustLengthToInches("7'2"):
-			return 89
-		case MustLengthToInches("7'3"):
-			return 90
-		case MustLengthToInches("7'4"):
-			return 91
-		case MustLengthToInches("7'5"):
-			return 92
-		}
-	case MustLengthToInches("7'0"): // 84"
-		switch wingspanInches {
-		case MustLengthToInches("7'0"):
-			return 83
-		case MustLengthToInches("7'1"):
-			return 84
-		case MustLengthToInches("7'2"):
-			return 85
-		case MustLengthToInches("7'3"):
-			return 86
-		case MustLengthToInches("7'4"):
-			return 87
-		case MustLengthToInches("7'5"):
-			return 88
-		case MustLengthToInches("7'6"):
-			return 89
-		}
-	case MustLengthToInches("7'1"): // 85"
-		switch wingspanInches {
-		case MustLengthToInches("7'1"):
-			return 77
-		case MustLengthToInches("7'2"):
-			return 78
-		case MustLengthToInches("7'3"):
-			return 79
-		case MustLengthToInches("7'4"):
-			return 80
-		case MustLengthToInches("7'5"):
-			return 81
-		case MustLengthToInches("7'6"):
-			return 82
-		case MustLengthToInches("7'7"):
-			return 82
-		}
-	case MustLengthToInches("7'2"): // 86"
-		switch wingspanInches {
-		case MustLengthToInches("7'2"):
-			return 72
-		case MustLengthToInches("7'3"):
-			return 72
-		case MustLengthToInches("7'4"):
-			return 73
-		case MustLengthToInches("7'5"):
-			return 74
-		case MustLengthToInches("7'6"):
-			return 75
-		case MustLengthToInches("7'7"):
-			return 76
-		case MustLengthToInches("7'8"):
-			return 77
-		}
-	case MustLengthToInches("7'3"): // 87"
-		switch wingspanInches {
-		case MustLengthToInches("7'3"):
-			return 68
-		case MustLengthToInches("7'4"):
-			return 69
-		case MustLengthToInches("7'5"):
-			return 69
-		case MustLengthToInches("7'6"):
-			return 70
-		case MustLengthToInches("7'7"):
-			return 71
-		case MustLengthToInches("7'8"):
-			return 72
-		case MustLengthToInches("7'9"):
-			return 72
-		}
-	case MustLengthToInches("7'4"): // 88"
-		switch wingspanInches {
-		case MustLengthToInches("7'4"):
-			return 66
-		case MustLengthToInches("7'5"):
-			return 67
-		case MustLengthToInches("7'6"):
-			return 68
-		case MustLengthToInches("7'7"):
-			return 68
-		case MustLengthToInches("7'8"):
-			return 69
-		case MustLengthToInches("7'9"):
-			return 70
-		case MustLengthToInches("7'10"):
-			return 70
+// drivingDunkWingspanTable maps height → wingspan → Driving Dunk cap
+// Values were recorded at 270 lbs and are treated as weight-independent, except
+// the builds in drivingDunkWeightTable. Weight also lowers the cap at 7'0"+ but is
+// not modeled yet (see docs/DATA-INCONSISTENCY-ISSUE.md)
+// Missing wingspans have not been tested and return 0
+var drivingDunkWingspanTable = map[int]map[int]int{
+	79: {79: 95, 80: 97, 81: 98, 85: 99},                         // 6'7"
+	80: {80: 94, 81: 95, 82: 96, 83: 98, 84: 99, 86: 99},         // 6'8"
+	81: {81: 92, 82: 93, 83: 94, 84: 95, 85: 96, 86: 98, 87: 99}, // 6'9"
+	82: {82: 90, 83: 91, 84: 92, 85: 93, 86: 94, 87: 95, 88: 96}, // 6'10"
+	83: {85: 88, 86: 89, 87: 90, 88: 91, 89: 92},                 // 6'11" (6'11"/7'0" wingspans depend on weight)
+	84: {84: 83, 85: 84, 86: 85, 87: 86, 88: 87, 89: 88, 90: 89}, // 7'0"
+	85: {85: 77, 86: 78, 87: 79, 88: 80, 89: 81, 90: 82, 91: 82}, // 7'1"
+	86: {86: 72, 87: 72, 88: 73, 89: 74, 90: 75, 91: 76, 92: 77}, // 7'2"
+	87: {87: 68, 88: 69, 89: 69, 90: 70, 91: 71, 92: 72, 93: 72}, // 7'3"
+	88: {88: 66, 89: 67, 90: 68, 91: 68, 92: 69, 93: 70, 94: 70}, // 7'4"
+}
+
+// drivingDunkWeightTable maps height → wingspan → Driving Dunk cap by weight
+// Format: if weight <= maxWeight, return value (check in order)
+// Thresholds were found by manual testing in 1 lb steps
+var drivingDunkWeightTable = map[int]map[int][]weightThreshold{
+	83: { // 6'11"
+		83: {{225, 87}, {268, 86}, {99999, 85}}, // 6'11" wingspan
+		84: {{229, 88}, {271, 87}, {99999, 86}}, // 7'0" wingspan
+	},
+}
+
+// thresholdValue returns the value of the first threshold covering weightLbs
+func thresholdValue(thresholds []weightThreshold, weightLbs int) int {
+	for _, t := range thresholds {
+		if weightLbs <= t.maxWeight {
+			return t.value
 		}
 	}
 	return 0
 }
 
+// DrivingDunk calculates the Driving Dunk attribute cap for a Center.
+// Formula: weight thresholds where weight is known to matter, else the wingspan table
+// - Wingspan raises the cap ~1 point per inch
+// - Weight lowers the cap for 6'11" builds with a 6'11" or 7'0" wingspan
+// TODO: Model weight at 7'0"+ once the scraped grid is fitted (cmd/fit-driving-dunk)
+func DrivingDunk(heightInches, weightLbs, wingspanInches int) int {
+	if thresholds, ok := drivingDunkWeightTable[heightInches][wingspanInches]; ok {
+		return thresholdValue(thresholds, weightLbs)
+	}
+	return drivingDunkWingspanTable[heightInches][wingspanInches] // 0 if untested
+}
+
 // DrivingDunk2 calculates Driving Dunk using an additive deficit model.
 // Formula: 99 - heightDeficit - wingspanDeficit - weightDeficit = Final Cap
 //
-// Current implementation: height and wingspan deficits are complete.
-// Weight deficit will be added after testing weight variations.
+// Weight deficit applies where drivingDunkWeightTable has thresholds.
 func DrivingDunk2(heightInches, weightLbs, wingspanInches int) int {
 	var heightDeficit int
 	var wingspanDeficit int
@@ -541,10 +403,12 @@ func DrivingDunk2(heightInches, weightLbs, wingspanInches int) int {
 	}
 
 	// Step 3: Calculate weight deficit (heavier = larger deficit)
-	// TODO: Implement after weight testing is complete
-	// Known data points for 7'4": 260 lbs → 64 cap, 290 lbs → 59 cap (rate: -1 per 6 lbs)
-	// See docs/DATA-INCONSISTENCY-ISSUE.md for blocking issue with baseline weight
-	weightDeficit = 0 // Placeholder until testing resolves data inconsistency
+	// Measured from the cap above, so a light build can have a negative deficit
+	// TODO: Known data points for 7'4": 260 lbs → 64 cap, 290 lbs → 59 cap (not modeled yet)
+	// See docs/DATA-INCONSISTENCY-ISSUE.md
+	if thresholds, ok := drivingDunkWeightTable[heightInches][wingspanInches]; ok {
+		weightDeficit = 99 - heightDeficit - wingspanDeficit - thresholdValue(thresholds, weightLbs)
+	}
 
 	// Step 4: Calculate final cap
 	finalCap := 99 - heightDeficit - wingspanDeficit - weightDeficit
@@ -320,11 +320,84 @@ func TestDrivingDunk(t *testing.T) {
 			want:           96,
 		},
 		// 6'11" height - wingspan variations
+		// The 270 lb reading for a 6'11" wingspan (86) conflicts with the 1 lb
+		// thresholds below and is kept in testdata/flagged_observations.json
+		// 6'11" with 6'11"/7'0" wingspan - weight thresholds (manual, 1 lb steps)
 		{
-			name:           "6'11\" with 6'11\" wingspan",
+			name:           "6'11\" with 6'11\" wingspan at 215 lbs",
 			heightInches:   MustLengthToInches("6'11"),
-			weightLbs:      270,
+			weightLbs:      215,
+			wingspanInches: MustLengthToInches("6'11"),
+			want:           87,
+		},
+		{
+			name:           "6'11\" with 6'11\" wingspan at 225 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      225,
+			wingspanInches: MustLengthToInches("6'11"),
+			want:           87,
+		},
+		{
+			name:           "6'11\" with 6'11\" wingspan at 226 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      226,
+			wingspanInches: MustLengthToInches("6'11"),
+			want:           86,
+		},
+		{
+			name:           "6'11\" with 6'11\" wingspan at 268 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      268,
+			wingspanInches: MustLengthToInches("6'11"),
+			want:           86,
+		},
+		{
+			name:           "6'11\" with 6'11\" wingspan at 269 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      269,
+			wingspanInches: MustLengthToInches("6'11"),
+			want:           85,
+		},
+		{
+			name:           "6'11\" with 6'11\" wingspan at 290 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      290,
 			wingspanInches: MustLengthToInches("6'11"),
+			want:           85,
+		},
+		{
+			name:           "6'11\" with 7'0\" wingspan at 229 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      229,
+			wingspanInches: MustLengthToInches("7'0"),
+			want:           88,
+		},
+		{
+			name:           "6'11\" with 7'0\" wingspan at 230 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      230,
+			wingspanInches: MustLengthToInches("7'0"),
+			want:           87,
+		},
+		{
+			name:           "6'11\" with 7'0\" wingspan at 271 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      271,
+			wingspanInches: MustLengthToInches("7'0"),
+			want:           87,
+		},
+		{
+			name:           "6'11\" with 7'0\" wingspan at 272 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      272,
+			wingspanInches: MustLengthToInches("7'0"),
+			want:           86,
+		},
+		{
+			name:           "6'11\" with 7'0\" wingspan at 290 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      290,
+			wingspanInches: MustLengthToInches("7'0"),
 			want:           86,
 		},
 		{
@@ -546,6 +619,63 @@ func TestDrivingDunk2(t *testing.T) {
 			wingspanInches: MustLengthToInches("7'2"),
 			wantCap:        72,
 		},
+		// Weight deficit (6'11" thresholds)
+		{
+			name:           "6'11\" with 6'11\" wingspan at 225 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      225,
+			wingspanInches: MustLengthToInches("6'11"),
+			wantCap:        87,
+		},
+		{
+			name:           "6'11\" with 6'11\" wingspan at 268 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      268,
+			wingspanInches: MustLengthToInches("6'11"),
+			wantCap:        86,
+		},
+		{
+			name:           "6'11\" with 6'11\" wingspan at 269 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      269,
+			wingspanInches: MustLengthToInches("6'11"),
+			wantCap:        85,
+		},
+		{
+			name:           "6'11\" with 7'0\" wingspan at 229 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      229,
+			wingspanInches: MustLengthToInches("7'0"),
+			wantCap:        88,
+		},
+		{
+			name:           "6'11\" with 7'0\" wingspan at 271 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      271,
+			wingspanInches: MustLengthToInches("7'0"),
+			wantCap:        87,
+		},
+		{
+			name:           "6'11\" with 7'0\" wingspan at 272 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      272,
+			wingspanInches: MustLengthToInches("7'0"),
+			wantCap:        86,
+		},
+		{
+			name:           "6'11\" with 7'1\" wingspan at 215 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      215,
+			wingspanInches: MustLengthToInches("7'1"),
+			wantCap:        88,
+		},
+		{
+			name:           "6'11\" with 7'1\" wingspan at 290 lbs",
+			heightInches:   MustLengthToInches("6'11"),
+			weightLbs:      290,
+			wingspanInches: MustLengthToInches("7'1"),
+			wantCap:        88,
+		},
 	}
 
 	for _, tt := range tests {
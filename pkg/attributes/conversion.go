@@ -3,7 +3,10 @@
 
 package attributes
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // LengthToInches converts a length string like "6'7"" or "6'7" to total inches (79)
 // Works for both height and wingspan measurements
@@ -42,6 +45,42 @@ func InchesToLength(totalInches int) string {
 	return fmt.Sprintf("%d'%d\"", feet, inches)
 }
 
+// ParseInches parses a height or wingspan given as feet-inches ("7-0") or inches ("84")
+func ParseInches(s string) (int, error) {
+	var feet, in int
+	if n, err := fmt.Sscanf(s, "%d-%d", &feet, &in); err == nil && n == 2 {
+		return feet*12 + in, nil
+	}
+
+	var inches int
+	if n, err := fmt.Sscanf(s, "%d", &inches); err == nil && n == 1 {
+		return inches, nil
+	}
+
+	return 0, fmt.Errorf("invalid height format %q (use 7-0 or 84)", s)
+}
+
+// ParseBuild parses a build given as height/wingspan/weight ("7-0/7-3/260" or "84/87/260")
+func ParseBuild(s string) (Build, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 3 {
+		return Build{}, fmt.Errorf("invalid build %q (use height/wingspan/weight, e.g. 7-0/7-3/260)", s)
+	}
+	height, err := ParseInches(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return Build{}, err
+	}
+	wingspan, err := ParseInches(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return Build{}, err
+	}
+	weight, err := WeightToInt(strings.TrimSpace(parts[2]))
+	if err != nil {
+		return Build{}, fmt.Errorf("invalid weight %q in %q", parts[2], s)
+	}
+	return Build{Height: height, Wingspan: wingspan, Weight: weight}, nil
+}
+
 // WeightToInt converts a weight string like "215" to int
 func WeightToInt(weight string) (int, error) {
 	var w int
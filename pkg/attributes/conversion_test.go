@@ -64,6 +64,43 @@ func TestInchesToLength(t *testing.T) {
 	}
 }
 
+func TestParseInches(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int
+	}{
+		{"7-0", 84},
+		{"6-11", 83},
+		{"84", 84},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseInches(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := ParseInches("tall")
+	assert.Error(t, err)
+}
+
+func TestParseBuild(t *testing.T) {
+	got, err := ParseBuild("7-0/7-3/260")
+	require.NoError(t, err)
+	assert.Equal(t, Build{Height: 84, Wingspan: 87, Weight: 260}, got)
+
+	got, err = ParseBuild("84 / 87 / 260")
+	require.NoError(t, err)
+	assert.Equal(t, Build{Height: 84, Wingspan: 87, Weight: 260}, got)
+
+	for _, input := range []string{"7-0/7-3", "7-0/long/260", "7-0/7-3/heavy"} {
+		_, err := ParseBuild(input)
+		assert.Error(t, err, input)
+	}
+}
+
 func TestWeightToInt(t *testing.T) {
 	tests := []struct {
 		weight string
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+package attributes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// DrivingDunkFit is the Driving Dunk model derived from a scraped build grid
+// It has the same shape as DrivingDunk: a wingspan table for builds whose cap
+// does not depend on weight, and weight thresholds for those that do
+type DrivingDunkFit struct {
+	wingspan map[int]map[int]int               // height → wingspan → cap
+	weight   map[int]map[int][]weightThreshold // height → wingspan → thresholds
+
+	Builds int // scraped builds used
+}
+
+// FitMismatch is a scraped build whose cap differs from a model
+type FitMismatch struct {
+	Build   Build
+	Scraped int
+	Model   int
+}
+
+// String describes the mismatch
+func (m FitMismatch) String() string {
+	return fmt.Sprintf("%s: scraped %d, model %d", m.Build, m.Scraped, m.Model)
+}
+
+// FitDrivingDunk derives the wingspan table and weight thresholds from scraped builds
+// A height/wingspan whose cap is the same at every scraped weight goes in the
+// wingspan table; otherwise runs of equal caps become weight thresholds ending at
+// the heaviest scraped weight of each run. The fit reproduces every scraped build.
+func FitDrivingDunk(caps []scraper.AttributeCaps) (*DrivingDunkFit, error) {
+	if len(caps) == 0 {
+		return nil, fmt.Errorf("no builds to fit")
+	}
+
+	// Step 1: scraped caps by height/wingspan and weight
+	cells := make(map[[2]int]map[int]int)
+	for i := range caps {
+		c := &caps[i]
+		key := [2]int{c.Height, c.Wingspan}
+		if cells[key] == nil {
+			cells[key] = make(map[int]int)
+		}
+		if prev, ok := cells[key][c.Weight]; ok && prev != c.DrivingDunk {
+			return nil, fmt.Errorf("build %s scraped as both %d and %d", buildOf(c), prev, c.DrivingDunk)
+		}
+		cells[key][c.Weight] = c.DrivingDunk
+	}
+
+	// Step 2: collapse each height/wingspan's weights into thresholds
+	fit := &DrivingDunkFit{
+		wingspan: make(map[int]map[int]int),
+		weight:   make(map[int]map[int][]weightThreshold),
+		Builds:   len(caps),
+	}
+	for key, byWeight := range cells {
+		height, ws := key[0], key[1]
+
+		var thresholds []weightThreshold
+		for _, w := range sortedKeys(byWeight) {
+			if n := len(thresholds); n > 0 && thresholds[n-1].value == byWeight[w] {
+				thresholds[n-1].maxWeight = w
+				continue
+			}
+			thresholds = append(thresholds, weightThreshold{w, byWeight[w]})
+		}
+		thresholds[len(thresholds)-1].maxWeight = 99999
+
+		if len(thresholds) == 1 {
+			if fit.wingspan[height] == nil {
+				fit.wingspan[height] = make(map[int]int)
+			}
+			fit.wingspan[height][ws] = thresholds[0].value
+			continue
+		}
+		if fit.weight[height] == nil {
+			fit.weight[height] = make(map[int][]weightThreshold)
+		}
+		fit.weight[height][ws] = thresholds
+	}
+
+	return fit, nil
+}
+
+// Cap evaluates the fitted model (same signature as the calculators)
+func (f *DrivingDunkFit) Cap(heightInches, weightLbs, wingspanInches int) int {
+	if thresholds, ok := f.weight[heightInches][wingspanInches]; ok {
+		return thresholdValue(thresholds, weightLbs)
+	}
+	return f.wingspan[heightInches][wingspanInches]
+}
+
+// GoSource renders the fitted tables as drivingDunkWingspanTable and
+// drivingDunkWeightTable literals for center.go
+func (f *DrivingDunkFit) GoSource() string {
+	var sb strings.Builder
+
+	sb.WriteString("var drivingDunkWingspanTable = map[int]map[int]int{\n")
+	for _, height := range sortedKeys(f.wingspan) {
+		row := f.wingspan[height]
+		var cells []string
+		for _, ws := range sortedKeys(row) {
+			cells = append(cells, fmt.Sprintf("%d: %d", ws, row[ws]))
+		}
+		fmt.Fprintf(&sb, "\t%d: {%s}, // %s\n", height, strings.Join(cells, ", "), InchesToLength(height))
+	}
+	sb.WriteString("}\n\n")
+
+	sb.WriteString("var drivingDunkWeightTable = map[int]map[int][]weightThreshold{\n")
+	for _, height := range sortedKeys(f.weight) {
+		fmt.Fprintf(&sb, "\t%d: { // %s\n", height, InchesToLength(height))
+		for _, ws := range sortedKeys(f.weight[height]) {
+			var cells []string
+			for _, t := range f.weight[height][ws] {
+				cells = append(cells, fmt.Sprintf("{%d, %d}", t.maxWeight, t.value))
+			}
+			fmt.Fprintf(&sb, "\t\t%d: {%s}, // %s wingspan\n", ws, strings.Join(cells, ", "), InchesToLength(ws))
+		}
+		sb.WriteString("\t},\n")
+	}
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+// CompareDrivingDunk lists the scraped builds DrivingDunk does not reproduce
+func CompareDrivingDunk(caps []scraper.AttributeCaps) []FitMismatch {
+	var mismatches []FitMismatch
+	for i := range caps {
+		c := &caps[i]
+		if model := DrivingDunk(c.Height, c.Weight, c.Wingspan); model != c.DrivingDunk {
+			mismatches = append(mismatches, FitMismatch{Build: buildOf(c), Scraped: c.DrivingDunk, Model: model})
+		}
+	}
+	return mismatches
+}
+
+// sortedKeys returns the keys of an int-keyed map in ascending order
+func sortedKeys[V any](m map[int]V) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
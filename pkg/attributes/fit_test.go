@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+package attributes
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFitDrivingDunk verifies the fit recovers DrivingDunk from a grid it generated
+func TestFitDrivingDunk(t *testing.T) {
+	caps := CenterCapTable().EvaluateAll(5)
+
+	fit, err := FitDrivingDunk(caps)
+	require.NoError(t, err)
+	assert.Equal(t, len(caps), fit.Builds)
+
+	for _, c := range caps {
+		require.Equal(t, c.DrivingDunk, fit.Cap(c.Height, c.Weight, c.Wingspan), "build %s", buildOf(&c))
+	}
+
+	src := fit.GoSource()
+	assert.Contains(t, src, "88: {88: 66, 89: 67, 90: 68, 91: 68, 92: 69, 93: 70, 94: 70}, // 7'4\"")
+	assert.Contains(t, src, "83: {85: 88, 86: 89, 87: 90, 88: 91, 89: 92}, // 6'11\"")
+	assert.Contains(t, src, "83: { // 6'11\"\n\t\t83: {{225, 87}, {265, 86}, {99999, 85}}, // 6'11\" wingspan\n")
+	assert.NotContains(t, src, "79: { // 6'7\"", "weight-independent heights have no weight row")
+}
+
+// TestFitDrivingDunk_Weight verifies weight-dependent builds become thresholds
+func TestFitDrivingDunk_Weight(t *testing.T) {
+	caps := []scraper.AttributeCaps{
+		{Height: 88, Wingspan: 88, Weight: 260, DrivingDunk: 64},
+		{Height: 88, Wingspan: 88, Weight: 230, DrivingDunk: 66},
+		{Height: 88, Wingspan: 88, Weight: 290, DrivingDunk: 59},
+		{Height: 88, Wingspan: 88, Weight: 270, DrivingDunk: 64},
+		{Height: 88, Wingspan: 94, Weight: 230, DrivingDunk: 70},
+		{Height: 88, Wingspan: 94, Weight: 290, DrivingDunk: 70},
+	}
+
+	fit, err := FitDrivingDunk(caps)
+	require.NoError(t, err)
+	for _, c := range caps {
+		assert.Equal(t, c.DrivingDunk, fit.Cap(c.Height, c.Weight, c.Wingspan), "build %s", buildOf(&c))
+	}
+
+	src := fit.GoSource()
+	assert.Contains(t, src, "88: {94: 70}, // 7'4\"")
+	assert.Contains(t, src, "88: {{230, 66}, {270, 64}, {99999, 59}}, // 7'4\" wingspan")
+
+	// The same build scraped twice with different caps cannot be fitted
+	_, err = FitDrivingDunk(append(caps, scraper.AttributeCaps{Height: 88, Wingspan: 88, Weight: 260, DrivingDunk: 63}))
+	assert.Error(t, err)
+
+	_, err = FitDrivingDunk(nil)
+	assert.Error(t, err)
+}
+
+// flaggedObservation is a recorded build the calculators knowingly disagree with
+type flaggedObservation struct {
+	scraper.AttributeCaps
+	Source string `json:"source"`
+	// Flag explains why the observation is not reproduced
+	Flag string `json:"flag"`
+}
+
+// loadFlaggedObservations reads testdata/flagged_observations.json
+func loadFlaggedObservations(t *testing.T) []flaggedObservation {
+	t.Helper()
+	data, err := os.ReadFile("testdata/flagged_observations.json")
+	require.NoError(t, err)
+	var flagged []flaggedObservation
+	require.NoError(t, json.Unmarshal(data, &flagged))
+	for _, f := range flagged {
+		require.NotEmpty(t, f.Flag, "flagged observation %s needs a reason", buildOf(&f.AttributeCaps))
+	}
+	return flagged
+}
+
+// TestDrivingDunk_ScrapedData verifies DrivingDunk against recorded builds
+// Every committed observation must match; flagged observations must still
+// disagree (see docs/DATA-INCONSISTENCY-ISSUE.md). The full scraped grid runs
+// when present.
+func TestDrivingDunk_ScrapedData(t *testing.T) {
+	caps, err := scraper.LoadCaps("testdata/center_observations.json")
+	require.NoError(t, err)
+	for _, m := range CompareDrivingDunk(caps) {
+		t.Errorf("DrivingDunk mismatch: %s", m)
+	}
+
+	for _, f := range loadFlaggedObservations(t) {
+		b := buildOf(&f.AttributeCaps)
+		got := DrivingDunk(b.Height, b.Weight, b.Wingspan)
+		if assert.NotEqual(t, f.DrivingDunk, got, "%s now matches; move it to center_observations.json", b) {
+			t.Logf("flagged: %s recorded %d, calculated %d: %s", b, f.DrivingDunk, got, f.Flag)
+		}
+	}
+
+	t.Run("full grid", func(t *testing.T) {
+		caps, err := scraper.LoadCaps("../../data/Center_caps.json")
+		if err != nil {
+			t.Skip("scraped data not available (data/Center_caps.json)")
+		}
+
+		// Regenerate the tables with: go run ./cmd/fit-driving-dunk
+		for _, m := range CompareDrivingDunk(caps) {
+			t.Errorf("DrivingDunk mismatch: %s", m)
+		}
+	})
+}
@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+package attributes
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// Dimension is a physical measurement an invariant varies
+type Dimension int
+
+const (
+	DimHeight Dimension = iota
+	DimWingspan
+	DimWeight
+)
+
+// String returns the dimension name
+func (d Dimension) String() string {
+	switch d {
+	case DimHeight:
+		return "height"
+	case DimWingspan:
+		return "wingspan"
+	case DimWeight:
+		return "weight"
+	}
+	return fmt.Sprintf("Dimension(%d)", int(d))
+}
+
+// Direction is how an attribute cap may move as a dimension increases
+type Direction int
+
+const (
+	NonIncreasing Direction = iota // larger never raises the cap
+	NonDecreasing                  // larger never lowers the cap
+	Constant                       // the dimension has no effect
+)
+
+// String returns the direction name
+func (d Direction) String() string {
+	switch d {
+	case NonIncreasing:
+		return "never raises"
+	case NonDecreasing:
+		return "never lowers"
+	case Constant:
+		return "never changes"
+	}
+	return fmt.Sprintf("Direction(%d)", int(d))
+}
+
+// Invariant declares how an attribute cap moves along one dimension
+// e.g. {"Driving Layup", DimHeight, NonIncreasing}: taller never raises Driving Layup
+type Invariant struct {
+	Attribute string
+	Dimension Dimension
+	Direction Direction
+}
+
+// String returns the invariant in words, e.g. "taller never raises Driving Layup"
+func (inv Invariant) String() string {
+	larger := map[Dimension]string{
+		DimHeight:   "taller",
+		DimWingspan: "longer wingspan",
+		DimWeight:   "heavier",
+	}[inv.Dimension]
+	if larger == "" {
+		larger = "larger " + inv.Dimension.String()
+	}
+	return fmt.Sprintf("%s %s %s", larger, inv.Direction, inv.Attribute)
+}
+
+// holds reports whether moving from value a to value b (larger dimension) is allowed
+func (inv Invariant) holds(a, b int) bool {
+	switch inv.Direction {
+	case NonIncreasing:
+		return b <= a
+	case NonDecreasing:
+		return b >= a
+	default:
+		return a == b
+	}
+}
+
+// CenterInvariants are the monotonicity rules observed for Center caps
+// (see docs/center-findings.md and docs/PRE-VS-POST-SCRAPING.md)
+var CenterInvariants = []Invariant{
+	{"Close Shot", DimHeight, Constant},
+	{"Close Shot", DimWingspan, Constant},
+	{"Close Shot", DimWeight, Constant},
+	{"Pass Accuracy", DimHeight, Constant},
+	{"Pass Accuracy", DimWingspan, Constant},
+	{"Pass Accuracy", DimWeight, Constant},
+	{"Driving Layup", DimHeight, NonIncreasing},
+	{"Driving Layup", DimWingspan, Constant},
+	{"Driving Layup", DimWeight, NonIncreasing},
+	{"Driving Dunk", DimHeight, NonIncreasing},
+	{"Driving Dunk", DimWingspan, NonDecreasing},
+	{"Driving Dunk", DimWeight, NonIncreasing},
+}
+
+// Violation is a pair of neighboring builds that breaks an invariant
+// From and To differ only in the invariant's dimension (From < To)
+type Violation struct {
+	Invariant Invariant
+	From      Build
+	To        Build
+	FromValue int
+	ToValue   int
+}
+
+// String describes the violation with both neighboring builds
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s = %d → %s = %d", v.Invariant, v.From, v.FromValue, v.To, v.ToValue)
+}
+
+// CheckInvariants runs invariants over a set of builds
+// Each build is compared with its nearest neighbor along the invariant's
+// dimension (same other two measurements), so 1 lb and 5 lb grids both work.
+// A cap of 0 means "not modeled"; those builds are skipped, so the check
+// bridges gaps in a lookup table instead of reporting them.
+func CheckInvariants(invariants []Invariant, caps []scraper.AttributeCaps) ([]Violation, error) {
+	var violations []Violation
+
+	for _, inv := range invariants {
+		if _, ok := (&scraper.AttributeCaps{}).Get(inv.Attribute); !ok {
+			return nil, fmt.Errorf("invariant %q: unknown attribute %q", inv, inv.Attribute)
+		}
+
+		// Group builds into lines that vary only along the invariant's dimension
+		lines := make(map[[2]int][]int)
+		for i := range caps {
+			if value, _ := caps[i].Get(inv.Attribute); value == 0 {
+				continue
+			}
+			key, _ := lineKey(buildOf(&caps[i]), inv.Dimension)
+			lines[key] = append(lines[key], i)
+		}
+
+		keys := make([][2]int, 0, len(lines))
+		for key := range lines {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if keys[i][0] != keys[j][0] {
+				return keys[i][0] < keys[j][0]
+			}
+			return keys[i][1] < keys[j][1]
+		})
+
+		for _, key := range keys {
+			line := lines[key]
+			sort.Slice(line, func(i, j int) bool {
+				_, a := lineKey(buildOf(&caps[line[i]]), inv.Dimension)
+				_, b := lineKey(buildOf(&caps[line[j]]), inv.Dimension)
+				return a < b
+			})
+
+			for i := 1; i < len(line); i++ {
+				from, to := &caps[line[i-1]], &caps[line[i]]
+
+				a, _ := from.Get(inv.Attribute)
+				b, _ := to.Get(inv.Attribute)
+				if !inv.holds(a, b) {
+					violations = append(violations, Violation{
+						Invariant: inv,
+						From:      buildOf(from),
+						To:        buildOf(to),
+						FromValue: a,
+						ToValue:   b,
+					})
+				}
+			}
+		}
+	}
+
+	return violations, nil
+}
+
+// Coverage is what a set of invariants leaves unchecked in a set of builds
+type Coverage struct {
+	// Unmodeled lists the attributes no invariant covers, in scraper.AttributeNames order
+	Unmodeled []string
+	// Skipped counts, per covered attribute, the builds CheckInvariants leaves out
+	// because their cap is 0 (not modeled)
+	Skipped map[string]int
+}
+
+// InvariantCoverage reports the attributes and builds CheckInvariants does not check
+func InvariantCoverage(invariants []Invariant, caps []scraper.AttributeCaps) Coverage {
+	covered := make(map[string]bool)
+	for _, inv := range invariants {
+		covered[inv.Attribute] = true
+	}
+
+	coverage := Coverage{Skipped: make(map[string]int)}
+	for _, name := range scraper.AttributeNames {
+		if !covered[name] {
+			coverage.Unmodeled = append(coverage.Unmodeled, name)
+			continue
+		}
+		for i := range caps {
+			if value, _ := caps[i].Get(name); value == 0 {
+				coverage.Skipped[name]++
+			}
+		}
+	}
+	return coverage
+}
+
+// CheckCenterCalculators runs invariants over every legal Center build (1 lb steps)
+func CheckCenterCalculators(invariants []Invariant) ([]Violation, error) {
+	return CheckInvariants(invariants, CenterCapTable().EvaluateAll(1))
+}
+
+// lineKey splits a build into the two fixed measurements and the varying one
+func lineKey(b Build, dim Dimension) ([2]int, int) {
+	switch dim {
+	case DimHeight:
+		return [2]int{b.Wingspan, b.Weight}, b.Height
+	case DimWingspan:
+		return [2]int{b.Height, b.Weight}, b.Wingspan
+	default:
+		return [2]int{b.Height, b.Wingspan}, b.Weight
+	}
+}
+
+// buildOf returns the measurements of a scraped build
+func buildOf(c *scraper.AttributeCaps) Build {
+	return Build{Height: c.Height, Wingspan: c.Wingspan, Weight: c.Weight}
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+package attributes
+
+import (
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckCenterCalculators verifies the calculators satisfy every declared invariant
+func TestCheckCenterCalculators(t *testing.T) {
+	violations, err := CheckCenterCalculators(CenterInvariants)
+	require.NoError(t, err)
+
+	for _, v := range violations {
+		t.Errorf("calculator violation: %s", v)
+	}
+}
+
+// TestCheckInvariants_Typo verifies a single bad table entry is reported with its neighbors
+func TestCheckInvariants_Typo(t *testing.T) {
+	caps := []scraper.AttributeCaps{
+		{Height: 85, Wingspan: 88, Weight: 240, DrivingLayup: 84},
+		{Height: 85, Wingspan: 88, Weight: 245, DrivingLayup: 83},
+		{Height: 85, Wingspan: 88, Weight: 250, DrivingLayup: 88}, // typo: should be 82
+		{Height: 85, Wingspan: 88, Weight: 255, DrivingLayup: 82},
+	}
+
+	violations, err := CheckInvariants([]Invariant{{"Driving Layup", DimWeight, NonIncreasing}}, caps)
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+
+	v := violations[0]
+	assert.Equal(t, Build{Height: 85, Wingspan: 88, Weight: 245}, v.From)
+	assert.Equal(t, Build{Height: 85, Wingspan: 88, Weight: 250}, v.To)
+	assert.Equal(t, 83, v.FromValue)
+	assert.Equal(t, 88, v.ToValue)
+	assert.Equal(t,
+		"heavier never raises Driving Layup: 7'1\" / 7'4\" / 245 lbs = 83 → 7'1\" / 7'4\" / 250 lbs = 88",
+		v.String())
+}
+
+// TestCheckInvariants_Neighbors verifies builds are only compared along one dimension
+func TestCheckInvariants_Neighbors(t *testing.T) {
+	caps := []scraper.AttributeCaps{
+		{Height: 84, Wingspan: 86, Weight: 260, DrivingDunk: 85},
+		{Height: 84, Wingspan: 87, Weight: 260, DrivingDunk: 86},
+		{Height: 84, Wingspan: 87, Weight: 290, DrivingDunk: 80}, // heavier, different line
+		{Height: 84, Wingspan: 89, Weight: 260, DrivingDunk: 0},  // not modeled, skipped
+		{Height: 84, Wingspan: 90, Weight: 260, DrivingDunk: 84}, // shorter neighbor is 87
+	}
+
+	violations, err := CheckInvariants([]Invariant{{"Driving Dunk", DimWingspan, NonDecreasing}}, caps)
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	assert.Equal(t, Build{Height: 84, Wingspan: 87, Weight: 260}, violations[0].From)
+	assert.Equal(t, Build{Height: 84, Wingspan: 90, Weight: 260}, violations[0].To)
+}
+
+// TestCheckInvariants_Constant verifies Constant invariants catch changes in either direction
+func TestCheckInvariants_Constant(t *testing.T) {
+	caps := []scraper.AttributeCaps{
+		{Height: 84, Wingspan: 86, Weight: 260, CloseShot: 99},
+		{Height: 85, Wingspan: 86, Weight: 260, CloseShot: 98},
+	}
+
+	violations, err := CheckInvariants([]Invariant{{"Close Shot", DimHeight, Constant}}, caps)
+	require.NoError(t, err)
+	assert.Len(t, violations, 1)
+}
+
+// TestCheckInvariants_UnknownAttribute verifies invariants must name a real attribute
+func TestCheckInvariants_UnknownAttribute(t *testing.T) {
+	_, err := CheckInvariants([]Invariant{{"Dunk", DimHeight, NonIncreasing}}, nil)
+	assert.Error(t, err)
+}
+
+// TestInvariantCoverage verifies unmodeled attributes and skipped builds are reported
+func TestInvariantCoverage(t *testing.T) {
+	caps := []scraper.AttributeCaps{
+		{Height: 84, Wingspan: 86, Weight: 260, DrivingDunk: 85, Block: 99},
+		{Height: 84, Wingspan: 89, Weight: 260, DrivingDunk: 0},
+	}
+
+	coverage := InvariantCoverage([]Invariant{{"Driving Dunk", DimWingspan, NonDecreasing}}, caps)
+	assert.Len(t, coverage.Unmodeled, len(scraper.AttributeNames)-1)
+	assert.NotContains(t, coverage.Unmodeled, "Driving Dunk")
+	assert.Contains(t, coverage.Unmodeled, "Block")
+	assert.Equal(t, map[string]int{"Driving Dunk": 1}, coverage.Skipped)
+}
+
+// TestCheckInvariants_ScrapedData runs the invariants over recorded builds
+// The committed observations always run; the full scraped grid runs when present.
+func TestCheckInvariants_ScrapedData(t *testing.T) {
+	caps, err := scraper.LoadCaps("testdata/center_observations.json")
+	require.NoError(t, err)
+	for _, f := range loadFlaggedObservations(t) {
+		caps = append(caps, f.AttributeCaps)
+	}
+
+	violations, err := CheckInvariants(CenterInvariants, caps)
+	require.NoError(t, err)
+	for _, v := range violations {
+		t.Errorf("data violation: %s", v)
+	}
+
+	t.Run("full grid", func(t *testing.T) {
+		caps, err := scraper.LoadCaps("../../data/Center_caps.json")
+		if err != nil {
+			t.Skip("scraped data not available (data/Center_caps.json)")
+		}
+
+		violations, err := CheckInvariants(CenterInvariants, caps)
+		require.NoError(t, err)
+
+		// Suspicious data is logged for review rather than failing the build
+		for _, v := range violations {
+			t.Logf("data violation: %s", v)
+		}
+	})
+}
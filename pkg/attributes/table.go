@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+package attributes
+
+import (
+	"sync"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// CapTable holds every attribute cap for every legal build in dense arrays
+// indexed by height, wingspan, and weight. Lookups are array reads, so
+// evaluating the whole build grid avoids re-running the calculators.
+type CapTable struct {
+	position    string
+	minHeight   int
+	minWingspan int
+	minWeight   int
+	heights     int
+	wingspans   int
+	weights     int
+
+	// values[cell*attrCount + attr] holds the cap for AttributeNames[attr]
+	values []uint8
+	legal  []bool
+	count  int // number of legal cells
+}
+
+// attrCount is the number of rated attributes stored per build
+var attrCount = len(scraper.AttributeNames)
+
+var (
+	centerCapTable     *CapTable
+	centerCapTableOnce sync.Once
+)
+
+// CenterCapTable returns the precomputed Center cap table, building it on first use
+func CenterCapTable() *CapTable {
+	centerCapTableOnce.Do(func() {
+		centerCapTable = NewCenterCapTable()
+	})
+	return centerCapTable
+}
+
+// NewCenterCapTable evaluates the Center calculators for every legal build (1 lb steps)
+func NewCenterCapTable() *CapTable {
+	builds := LegalCenterBuilds(1)
+
+	t := &CapTable{position: "Center"}
+	t.minHeight, t.minWingspan, t.minWeight = builds[0].Height, builds[0].Wingspan, builds[0].Weight
+	maxHeight, maxWingspan, maxWeight := t.minHeight, t.minWingspan, t.minWeight
+	for _, b := range builds {
+		t.minWingspan = min(t.minWingspan, b.Wingspan)
+		t.minWeight = min(t.minWeight, b.Weight)
+		maxHeight = max(maxHeight, b.Height)
+		maxWingspan = max(maxWingspan, b.Wingspan)
+		maxWeight = max(maxWeight, b.Weight)
+	}
+
+	t.heights = maxHeight - t.minHeight + 1
+	t.wingspans = maxWingspan - t.minWingspan + 1
+	t.weights = maxWeight - t.minWeight + 1
+
+	cells := t.heights * t.wingspans * t.weights
+	t.values = make([]uint8, cells*attrCount)
+	t.legal = make([]bool, cells)
+
+	calcs := make([]CapFunc, attrCount)
+	for i, name := range scraper.AttributeNames {
+		calcs[i] = CenterCalculators[name]
+	}
+
+	for _, b := range builds {
+		cell, _ := t.cell(b)
+		t.legal[cell] = true
+		t.count++
+		for i, calc := range calcs {
+			t.values[cell*attrCount+i] = uint8(calc(b.Height, b.Weight, b.Wingspan))
+		}
+	}
+
+	return t
+}
+
+// cell returns the flat index for a build, or false if it is outside the table
+func (t *CapTable) cell(b Build) (int, bool) {
+	h := b.Height - t.minHeight
+	ws := b.Wingspan - t.minWingspan
+	w := b.Weight - t.minWeight
+	if h < 0 || h >= t.heights || ws < 0 || ws >= t.wingspans || w < 0 || w >= t.weights {
+		return 0, false
+	}
+	return (h*t.wingspans+ws)*t.weights + w, true
+}
+
+// Cap returns a single attribute cap for a legal build
+// attr is an index into scraper.AttributeNames
+func (t *CapTable) Cap(b Build, attr int) (int, bool) {
+	cell, ok := t.cell(b)
+	if !ok || !t.legal[cell] || attr < 0 || attr >= attrCount {
+		return 0, false
+	}
+	return int(t.values[cell*attrCount+attr]), true
+}
+
+// Lookup returns every attribute cap for a legal build
+func (t *CapTable) Lookup(b Build) (scraper.AttributeCaps, bool) {
+	cell, ok := t.cell(b)
+	if !ok || !t.legal[cell] {
+		return scraper.AttributeCaps{}, false
+	}
+	return t.capsAt(b, cell), true
+}
+
+// EvaluateAll returns the caps of every legal build in LegalCenterBuilds order
+// Weights step from each height's minimum by weightStep lbs
+func (t *CapTable) EvaluateAll(weightStep int) []scraper.AttributeCaps {
+	if weightStep < 1 {
+		weightStep = 1
+	}
+
+	results := make([]scraper.AttributeCaps, 0, t.count/weightStep+t.heights*t.wingspans)
+	for h := 0; h < t.heights; h++ {
+		for ws := 0; ws < t.wingspans; ws++ {
+			row := (h*t.wingspans + ws) * t.weights
+
+			// Legal weights are contiguous, so step from the first legal one
+			first := 0
+			for first < t.weights && !t.legal[row+first] {
+				first++
+			}
+
+			for w := first; w < t.weights && t.legal[row+w]; w += weightStep {
+				b := Build{Height: t.minHeight + h, Wingspan: t.minWingspan + ws, Weight: t.minWeight + w}
+				results = append(results, t.capsAt(b, row+w))
+			}
+		}
+	}
+
+	return results
+}
+
+// capsAt expands a table cell into AttributeCaps (field order matches scraper.AttributeNames)
+func (t *CapTable) capsAt(b Build, cell int) scraper.AttributeCaps {
+	v := t.values[cell*attrCount : (cell+1)*attrCount]
+	return scraper.AttributeCaps{
+		Position:         t.position,
+		Height:           b.Height,
+		Wingspan:         b.Wingspan,
+		Weight:           b.Weight,
+		CloseShot:        int(v[0]),
+		DrivingLayup:     int(v[1]),
+		DrivingDunk:      int(v[2]),
+		StandingDunk:     int(v[3]),
+		PostControl:      int(v[4]),
+		MidRangeShot:     int(v[5]),
+		ThreePointShot:   int(v[6]),
+		FreeThrow:        int(v[7]),
+		PassAccuracy:     int(v[8]),
+		BallHandle:       int(v[9]),
+		SpeedWithBall:    int(v[10]),
+		InteriorDefense:  int(v[11]),
+		PerimeterDefense: int(v[12]),
+		Steal:            int(v[13]),
+		Block:            int(v[14]),
+		OffensiveRebound: int(v[15]),
+		DefensiveRebound: int(v[16]),
+		Speed:            int(v[17]),
+		Agility:          int(v[18]),
+		Strength:         int(v[19]),
+		Vertical:         int(v[20]),
+	}
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+package attributes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLegalCenterBuilds verifies the build grid matches the scraper's 5 lb grid
+func TestLegalCenterBuilds(t *testing.T) {
+	// 10 heights × 7 wingspans × height-dependent weights (see docs/VALIDATION-SUMMARY.md)
+	builds := LegalCenterBuilds(5)
+	assert.Len(t, builds, 1001)
+
+	for _, b := range builds {
+		assert.True(t, IsLegalCenterBuild(b), "build %s should be legal", b)
+	}
+
+	assert.Equal(t, Build{Height: 79, Wingspan: 79, Weight: 215}, builds[0])
+	assert.Equal(t, Build{Height: 88, Wingspan: 94, Weight: 290}, builds[len(builds)-1])
+
+	assert.False(t, IsLegalCenterBuild(Build{Height: 79, Wingspan: 86, Weight: 215}), "wingspan above max")
+	assert.False(t, IsLegalCenterBuild(Build{Height: 88, Wingspan: 91, Weight: 225}), "weight below min")
+	assert.False(t, IsLegalCenterBuild(Build{Height: 78, Wingspan: 80, Weight: 220}), "height below min")
+}
+
+// TestCapTableMatchesCalculators verifies every table entry against the calculators
+func TestCapTableMatchesCalculators(t *testing.T) {
+	table := CenterCapTable()
+
+	for _, b := range LegalCenterBuilds(1) {
+		got, ok := table.Lookup(b)
+		require.True(t, ok, "build %s missing from table", b)
+
+		want := CenterCaps(b.Height, b.Weight, b.Wingspan)
+		require.Equal(t, *want, got, "build %s", b)
+	}
+
+	_, ok := table.Lookup(Build{Height: 79, Wingspan: 90, Weight: 215})
+	assert.False(t, ok, "illegal build inside the table bounds")
+
+	_, ok = table.Lookup(Build{Height: 95, Wingspan: 90, Weight: 215})
+	assert.False(t, ok, "build outside the table bounds")
+
+	dunk, ok := table.Cap(Build{Height: 84, Wingspan: 87, Weight: 260}, 2)
+	require.True(t, ok)
+	assert.Equal(t, DrivingDunk(84, 260, 87), dunk)
+}
+
+// TestEvaluateAll verifies the batch API covers every legal build in order
+func TestEvaluateAll(t *testing.T) {
+	builds := LegalCenterBuilds(5)
+	caps := CenterCapTable().EvaluateAll(5)
+	require.Len(t, caps, len(builds))
+
+	for i, b := range builds {
+		assert.Equal(t, b.Height, caps[i].Height)
+		assert.Equal(t, b.Wingspan, caps[i].Wingspan)
+		assert.Equal(t, b.Weight, caps[i].Weight)
+		assert.Equal(t, DrivingLayup(b.Height, b.Weight, b.Wingspan), caps[i].DrivingLayup)
+	}
+}
+
+// BenchmarkEvaluateAll_Calculators evaluates the full 1 lb grid through the calculators
+func BenchmarkEvaluateAll_Calculators(b *testing.B) {
+	builds := LegalCenterBuilds(1)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, build := range builds {
+			_ = CenterCaps(build.Height, build.Weight, build.Wingspan)
+		}
+	}
+}
+
+// BenchmarkEvaluateAll_Table evaluates the full 1 lb grid through the dense table
+func BenchmarkEvaluateAll_Table(b *testing.B) {
+	table := CenterCapTable()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = table.EvaluateAll(1)
+	}
+}
+
+// BenchmarkDrivingDunk_Calculator looks up a single Driving Dunk cap through the calculator
+func BenchmarkDrivingDunk_Calculator(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = DrivingDunk(88, 260, 91)
+	}
+}
+
+// BenchmarkDrivingDunk_Table looks up a single Driving Dunk cap through the dense table
+func BenchmarkDrivingDunk_Table(b *testing.B) {
+	table := CenterCapTable()
+	build := Build{Height: 88, Wingspan: 91, Weight: 260}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = table.Cap(build, 2)
+	}
+}
@@ -0,0 +1,97 @@
+package badges
+
+import (
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bandedCalculator returns a calculator with a badge whose Block thresholds vary by height
+// Block: 6'0"-6'8" needs 60/70/80/90/95, 6'9"-7'4" needs 70/80/88/94/99
+// Vertical: 5'9"-7'4" needs 50/60/70/80/90
+func bandedCalculator(t *testing.T) *Calculator {
+	t.Helper()
+
+	reqs := map[string]*BadgeRequirements{
+		"RimGuard": {
+			Name:     "Rim Guard",
+			Category: "Defense",
+			Type:     "Primary",
+			Requirements: []AttributeRequirement{
+				{Attribute: "Block", Bronze: 60, Silver: 70, Gold: 80, HallOfFame: 90, Legendary: 95, MinHeight: 72, MaxHeight: 80},
+				{Attribute: "Block", Bronze: 70, Silver: 80, Gold: 88, HallOfFame: 94, Legendary: 99, MinHeight: 81, MaxHeight: 88},
+				{Attribute: "Vertical", Bronze: 50, Silver: 60, Gold: 70, HallOfFame: 80, Legendary: 90, MinHeight: 69, MaxHeight: 88},
+			},
+		},
+	}
+
+	calc, err := newCalculator(reqs, nil)
+	require.NoError(t, err)
+	return calc
+}
+
+// TestHeightBands tests that each build's height picks its own threshold row
+func TestHeightBands(t *testing.T) {
+	calc := bandedCalculator(t)
+
+	tests := []struct {
+		name         string
+		height       int
+		block        int
+		expectedTier BadgeTier
+	}{
+		{"Short band Gold", 78, 82, BadgeTierGold},
+		{"Tall band Silver with the same Block", 84, 82, BadgeTierSilver},
+		{"Tall band edge", 81, 88, BadgeTierGold},
+		{"Below every Block band", 70, 99, BadgeTierNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := &scraper.AttributeCaps{Height: tt.height, Block: tt.block, Vertical: 99}
+			tier, err := calc.GetBadgeTier("Rim Guard", attrs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTier, tier)
+		})
+	}
+}
+
+// TestHeightBands_Narrowest tests that overlapping bands resolve to the narrowest one
+func TestHeightBands_Narrowest(t *testing.T) {
+	reqs := []AttributeRequirement{
+		{Attribute: "Block", Bronze: 70, MinHeight: 69, MaxHeight: 88},
+		{Attribute: "Block", Bronze: 60, MinHeight: 84, MaxHeight: 88},
+	}
+
+	applicable, ok := applicableRequirements(reqs, 85)
+	require.True(t, ok)
+	require.Len(t, applicable, 1)
+	assert.Equal(t, 60, applicable[0].Bronze)
+
+	applicable, ok = applicableRequirements(reqs, 80)
+	require.True(t, ok)
+	assert.Equal(t, 70, applicable[0].Bronze)
+}
+
+// TestExplainBadge_HeightBands tests explanations report the applied band and the badge's full range
+func TestExplainBadge_HeightBands(t *testing.T) {
+	calc := bandedCalculator(t)
+
+	exp, err := calc.ExplainBadge("Rim Guard", &scraper.AttributeCaps{Height: 84, Block: 82, Vertical: 99})
+	require.NoError(t, err)
+	assert.True(t, exp.HeightAllowed)
+	assert.Equal(t, 72, exp.MinHeight, "Block bands start at 6'0\"")
+	assert.Equal(t, 88, exp.MaxHeight)
+	assert.Equal(t, "Block", exp.Bottleneck)
+	assert.Equal(t, 81, exp.Requirements[0].MinHeight)
+	assert.Equal(t, 88, exp.Requirements[0].Thresholds[BadgeTierGold])
+	assert.Equal(t, 6, exp.PointsToNextTier, "Block 82 → 88 in the tall band")
+
+	exp, err = calc.ExplainBadge("Rim Guard", &scraper.AttributeCaps{Height: 70, Block: 99, Vertical: 99})
+	require.NoError(t, err)
+	assert.False(t, exp.HeightAllowed)
+	assert.Equal(t, BadgeTierNone, exp.NextTier)
+	assert.Len(t, exp.Requirements, 2, "one row per attribute even when no band applies")
+}
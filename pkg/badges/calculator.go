@@ -2,6 +2,7 @@ package badges
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/jredh-dev/nba2k26/pkg/scraper"
@@ -9,84 +10,219 @@ import (
 
 // Calculator calculates badge tiers based on attribute caps
 type Calculator struct {
-	requirements map[string]*BadgeRequirements
+	badges  map[string]*Badge // keyed by badge ID
+	source  string            // where the requirements were loaded from
+	version string            // content hash of the requirements data
 }
 
 // NewCalculator creates a new badge calculator
-func NewCalculator() (*Calculator, error) {
-	reqs, err := LoadBadgeRequirements()
+// Requirements come from the embedded data/badge_requirements.json unless an
+// option such as WithRequirementsFile selects another source. Code-defined badges
+// from Register and WithBadges replace JSON-defined badges with the same ID.
+func NewCalculator(opts ...Option) (*Calculator, error) {
+	cfg := calculatorConfig{source: "embedded"}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var data []byte
+	var err error
+	if cfg.read == nil {
+		data, err = badgeDataFS.ReadFile("data/badge_requirements.json")
+	} else {
+		data, err = cfg.read()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read badge requirements: %w", err)
+	}
+
+	reqs, err := ParseBadgeRequirements(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", cfg.source, err)
+	}
+
+	descriptions, err := LoadBadgeDescriptions()
 	if err != nil {
 		return nil, err
 	}
 
-	return &Calculator{
-		requirements: reqs,
-	}, nil
+	calc, err := newCalculator(reqs, descriptions)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", cfg.source, err)
+	}
+	if err := calc.addCodeBadges(append(registeredBadges(), cfg.badges...), descriptions); err != nil {
+		return nil, err
+	}
+	calc.source = cfg.source
+	calc.version = dataVersion(data)
+	if ids := calc.codeBadgeIDs(); len(ids) > 0 {
+		calc.version = dataVersion(append(data, strings.Join(ids, ",")...))
+	}
+	return calc, nil
 }
 
-// GetBadgeTier calculates the maximum tier available for a specific badge
-func (c *Calculator) GetBadgeTier(badgeName string, attrs *scraper.AttributeCaps) (BadgeTier, error) {
-	// Convert badge name to ID format (e.g., "Posterizer" -> "Posterizer", "Ankle Assassin" -> "AnkleAssassin")
-	badgeID := strings.ReplaceAll(badgeName, " ", "")
-	badgeID = strings.ReplaceAll(badgeID, "-", "")
+// Source returns where the requirements were loaded from ("embedded", a file path, or "reader")
+func (c *Calculator) Source() string {
+	return c.source
+}
 
-	reqs, exists := c.requirements[badgeID]
-	if !exists {
-		return BadgeTierNone, fmt.Errorf("badge %q not found", badgeName)
-	}
+// DataVersion returns a short content hash of the requirements data
+// Two calculators with the same DataVersion score every build identically. The
+// hash also covers the IDs of code-defined badges, but not their Calc logic.
+func (c *Calculator) DataVersion() string {
+	return c.version
+}
 
-	// Check height restrictions (if any requirement has height restrictions, they apply)
-	for _, req := range reqs.Requirements {
-		if req.MinHeight > 0 && attrs.Height < req.MinHeight {
-			return BadgeTierNone, nil
+// newCalculator builds a calculator and its typed metadata from loaded requirements
+func newCalculator(reqs map[string]*BadgeRequirements, descriptions map[string]string) (*Calculator, error) {
+	badges := make(map[string]*Badge, len(reqs))
+	for id, r := range reqs {
+		category, err := ParseBadgeCategory(r.Category)
+		if err != nil {
+			return nil, fmt.Errorf("badge %q: %w", r.Name, err)
 		}
-		if req.MaxHeight > 0 && attrs.Height > req.MaxHeight {
-			return BadgeTierNone, nil
+		badgeType, err := ParseBadgeType(r.Type)
+		if err != nil {
+			return nil, fmt.Errorf("badge %q: %w", r.Name, err)
 		}
-	}
 
-	// Calculate tier based on requirements
-	// Primary badges: ALL requirements must be met
-	// Secondary badges: ANY requirement can be met
-	if reqs.Type == "Primary" {
-		return c.calculatePrimaryBadgeTier(reqs, attrs), nil
+		tree, err := buildRequirementTree(badgeType, r.Requirements)
+		if err != nil {
+			return nil, fmt.Errorf("badge %q: %w", r.Name, err)
+		}
+
+		badges[id] = &Badge{
+			ID:           id,
+			Name:         r.Name,
+			Category:     category,
+			Type:         badgeType,
+			Description:  descriptions[id],
+			Requirements: r.Requirements,
+			Tree:         tree,
+		}
 	}
 
-	return c.calculateSecondaryBadgeTier(reqs, attrs), nil
+	return &Calculator{badges: badges}, nil
 }
 
-// calculatePrimaryBadgeTier calculates tier when ALL requirements must be met
-func (c *Calculator) calculatePrimaryBadgeTier(reqs *BadgeRequirements, attrs *scraper.AttributeCaps) BadgeTier {
-	// Get the minimum tier across all requirements (bottleneck)
-	minTier := BadgeTierLegendary
+// Badge returns the metadata for a badge by name or ID
+func (c *Calculator) Badge(badgeName string) (Badge, error) {
+	id, exists := c.lookupID(badgeName)
+	if !exists {
+		return Badge{}, c.notFound(badgeName)
+	}
+	return *c.badges[id], nil
+}
 
-	for _, req := range reqs.Requirements {
-		attrValue := c.getAttributeValue(req.Attribute, attrs)
-		tier := c.getTierForRequirement(req, attrValue)
+// Badges returns the metadata for every badge, ordered by category then name
+func (c *Calculator) Badges() []Badge {
+	result := make([]Badge, 0, len(c.badges))
+	for _, b := range c.badges {
+		result = append(result, *b)
+	}
 
-		if tier < minTier {
-			minTier = tier
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Category != result[j].Category {
+			return result[i].Category < result[j].Category
 		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// GetBadgeTier calculates the maximum tier available for a specific badge
+func (c *Calculator) GetBadgeTier(badgeName string, attrs *scraper.AttributeCaps) (BadgeTier, error) {
+	eval, err := c.EvaluateBadge(badgeName, attrs)
+	if err != nil {
+		return BadgeTierNone, err
+	}
+	return eval.Tier, nil
+}
+
+// EvaluateBadge evaluates a badge's requirement tree for a build and reports
+// which branch of the tree met each tier
+func (c *Calculator) EvaluateBadge(badgeName string, attrs *scraper.AttributeCaps) (*BadgeEvaluation, error) {
+	id, exists := c.lookupID(badgeName)
+	if !exists {
+		return nil, c.notFound(badgeName)
 	}
+	badge := c.badges[id]
+
+	if badge.Calc != nil {
+		return &BadgeEvaluation{Name: badge.Name, Tier: badge.Calc(attrs)}, nil
+	}
+
+	result := c.evaluate(badge.Tree, attrs)
+	return &BadgeEvaluation{
+		Name:     badge.Name,
+		Tier:     result.tier,
+		Branches: result.branches,
+	}, nil
+}
 
-	return minTier
+// bandChoice is the requirement row picked for one attribute (ok is false if no band applies)
+type bandChoice struct {
+	req AttributeRequirement
+	ok  bool
 }
 
-// calculateSecondaryBadgeTier calculates tier when ANY requirement can be met
-func (c *Calculator) calculateSecondaryBadgeTier(reqs *BadgeRequirements, attrs *scraper.AttributeCaps) BadgeTier {
-	// Get the maximum tier across any requirement
-	maxTier := BadgeTierNone
+// selectBands picks one requirement row per attribute for a height, in row order
+// An attribute may have several rows for different height bands; the narrowest
+// band containing the height wins.
+func selectBands(requirements []AttributeRequirement, heightInches int) []bandChoice {
+	var choices []bandChoice
+	index := make(map[string]int) // attribute → position in choices
+
+	for _, req := range requirements {
+		i, seen := index[req.Attribute]
+		if !seen {
+			i = len(choices)
+			index[req.Attribute] = i
+			choices = append(choices, bandChoice{req: req})
+		}
 
-	for _, req := range reqs.Requirements {
-		attrValue := c.getAttributeValue(req.Attribute, attrs)
-		tier := c.getTierForRequirement(req, attrValue)
+		if !req.AppliesTo(heightInches) {
+			continue
+		}
+		if !choices[i].ok || req.bandWidth() < choices[i].req.bandWidth() {
+			choices[i] = bandChoice{req: req, ok: true}
+		}
+	}
+	return choices
+}
 
-		if tier > maxTier {
-			maxTier = tier
+// applicableRequirements picks one requirement row per attribute for a height
+// Returns false if any attribute has no band for the height, which makes the
+// badge unavailable.
+func applicableRequirements(requirements []AttributeRequirement, heightInches int) ([]AttributeRequirement, bool) {
+	choices := selectBands(requirements, heightInches)
+	applicable := make([]AttributeRequirement, 0, len(choices))
+	for _, choice := range choices {
+		if !choice.ok {
+			return nil, false
 		}
+		applicable = append(applicable, choice.req)
+	}
+	return applicable, true
+}
+
+// lookupID resolves a badge name or ID to its ID
+// IDs are names without spaces (e.g., "Ankle Assassin" -> "AnkleAssassin", "Post-Up Poet" -> "Post-UpPoet")
+func (c *Calculator) lookupID(badgeName string) (string, bool) {
+	id := strings.ReplaceAll(badgeName, " ", "")
+	if _, exists := c.badges[id]; exists {
+		return id, true
 	}
 
-	return maxTier
+	// Fall back to ignoring case and punctuation (e.g., "post up poet"), matching
+	// names too since a code-defined badge's ID need not follow its name
+	key := normalizeBadgeName(badgeName)
+	for id, b := range c.badges {
+		if normalizeBadgeName(id) == key || normalizeBadgeName(b.Name) == key {
+			return id, true
+		}
+	}
+	return "", false
 }
 
 // getTierForRequirement determines the tier based on a single attribute requirement
@@ -111,69 +247,49 @@ func (c *Calculator) getTierForRequirement(req AttributeRequirement, attrValue i
 	return BadgeTierNone
 }
 
-// getAttributeValue extracts the attribute value from AttributeCaps
-func (c *Calculator) getAttributeValue(attributeName string, attrs *scraper.AttributeCaps) int {
-	// Map attribute names from NBA2KLab to our struct fields
-	switch attributeName {
-	case "Close Shot":
-		return attrs.CloseShot
-	case "Driving Layup", "Layup":
-		return attrs.DrivingLayup
-	case "Driving Dunk":
-		return attrs.DrivingDunk
-	case "Standing Dunk":
-		return attrs.StandingDunk
-	case "Post Control":
-		return attrs.PostControl
-	case "Mid-Range Shot":
-		return attrs.MidRangeShot
-	case "Three-Point Shot":
-		return attrs.ThreePointShot
-	case "Free Throw":
-		return attrs.FreeThrow
-	case "Pass Accuracy":
-		return attrs.PassAccuracy
-	case "Ball Handle":
-		return attrs.BallHandle
-	case "Speed With Ball":
-		return attrs.SpeedWithBall
-	case "Interior Defense":
-		return attrs.InteriorDefense
-	case "Perimeter Defense":
-		return attrs.PerimeterDefense
-	case "Steal":
-		return attrs.Steal
-	case "Block":
-		return attrs.Block
-	case "Offensive Rebound":
-		return attrs.OffensiveRebound
-	case "Defensive Rebound":
-		return attrs.DefensiveRebound
-	case "Speed":
-		return attrs.Speed
-	case "Strength":
-		return attrs.Strength
-	case "Vertical":
-		return attrs.Vertical
-	case "Agility":
-		return attrs.Agility
-	default:
-		return 0
+// attributeAliases maps NBA2KLab attribute names to scraper.AttributeNames
+var attributeAliases = map[string]string{
+	"Layup": "Driving Layup",
+}
+
+// canonicalAttribute resolves an NBA2KLab attribute name to its scraper.AttributeNames name
+func canonicalAttribute(name string) string {
+	if alias, ok := attributeAliases[name]; ok {
+		return alias
 	}
+	return name
+}
+
+// knownAttribute reports whether an attribute name maps to an AttributeCaps field
+func knownAttribute(name string) bool {
+	_, ok := (&scraper.AttributeCaps{}).Get(canonicalAttribute(name))
+	return ok
+}
+
+// getAttributeValue extracts the attribute value from AttributeCaps (0 if unknown)
+func (c *Calculator) getAttributeValue(attributeName string, attrs *scraper.AttributeCaps) int {
+	return AttributeValue(attributeName, attrs)
+}
+
+// AttributeValue returns a build's value for a requirement attribute name,
+// accepting NBA2KLab aliases such as "Layup" (0 if unknown)
+func AttributeValue(attributeName string, attrs *scraper.AttributeCaps) int {
+	value, _ := attrs.Get(canonicalAttribute(attributeName))
+	return value
 }
 
 // GetAvailableBadges returns all badges available for a build (tier > None)
 func (c *Calculator) GetAvailableBadges(attrs *scraper.AttributeCaps) map[string]BadgeTier {
 	result := make(map[string]BadgeTier)
 
-	for _, reqs := range c.requirements {
-		tier, err := c.GetBadgeTier(reqs.Name, attrs)
+	for _, b := range c.badges {
+		tier, err := c.GetBadgeTier(b.ID, attrs)
 		if err != nil {
 			continue
 		}
 
 		if tier > BadgeTierNone {
-			result[reqs.Name] = tier
+			result[b.Name] = tier
 		}
 	}
 
@@ -183,58 +299,31 @@ func (c *Calculator) GetAvailableBadges(attrs *scraper.AttributeCaps) map[string
 // GetBadgesByCategory returns all badges in a specific category
 func (c *Calculator) GetBadgesByCategory(category BadgeCategory, attrs *scraper.AttributeCaps) map[string]BadgeTier {
 	result := make(map[string]BadgeTier)
-	categoryStr := c.mapCategoryToString(category)
 
-	for _, reqs := range c.requirements {
-		if !c.matchesCategory(reqs.Category, categoryStr) {
+	for _, b := range c.badges {
+		if b.Category != category {
 			continue
 		}
 
-		tier, err := c.GetBadgeTier(reqs.Name, attrs)
+		tier, err := c.GetBadgeTier(b.ID, attrs)
 		if err != nil {
 			continue
 		}
 
 		if tier > BadgeTierNone {
-			result[reqs.Name] = tier
+			result[b.Name] = tier
 		}
 	}
 
 	return result
 }
 
-// mapCategoryToString maps BadgeCategory enum to NBA2KLab category strings
-func (c *Calculator) mapCategoryToString(category BadgeCategory) string {
-	switch category {
-	case BadgeCategoryFinishing:
-		return "Inside Scoring"
-	case BadgeCategoryShooting:
-		return "Outside Scoring"
-	case BadgeCategoryPlaymaking:
-		return "Playmaking"
-	case BadgeCategoryDefense:
-		return "Defense"
-	case BadgeCategoryRebounding:
-		return "Rebounding"
-	case BadgeCategoryPhysicals:
-		return "General Offense"
-	case BadgeCategoryAllAround:
-		return "All Around"
-	default:
-		return ""
-	}
-}
-
-// matchesCategory checks if a badge category matches the filter
-func (c *Calculator) matchesCategory(badgeCat, filterCat string) bool {
-	return badgeCat == filterCat
-}
-
-// ListAllBadges returns all badge names
+// ListAllBadges returns all badge names, sorted
 func (c *Calculator) ListAllBadges() []string {
-	badges := make([]string, 0, len(c.requirements))
-	for _, reqs := range c.requirements {
-		badges = append(badges, reqs.Name)
+	badges := make([]string, 0, len(c.badges))
+	for _, b := range c.badges {
+		badges = append(badges, b.Name)
 	}
+	sort.Strings(badges)
 	return badges
 }
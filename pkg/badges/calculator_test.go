@@ -9,6 +9,21 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// testSheet is the small requirements sheet the tests share
+// Posterizer (Driving Dunk AND Vertical), Dimer, Glove, Anchor, and Interceptor
+// use 60/70/80/90/95; Disruptor is Steal OR Block (62/72/82/92/97 on Block),
+// Rim Guard is Block 65/75/85/93/98 AND Vertical 50/60/70/80/90, and Rebound
+// Chaser is Offensive OR Defensive Rebound.
+const testSheet = "testdata/sheet.json"
+
+// testCalculator returns a calculator loaded from testSheet
+func testCalculator(t *testing.T) *badges.Calculator {
+	t.Helper()
+	calc, err := badges.NewCalculator(badges.WithRequirementsFile(testSheet))
+	require.NoError(t, err)
+	return calc
+}
+
 // TestNewCalculator tests calculator creation
 func TestNewCalculator(t *testing.T) {
 	calc, err := badges.NewCalculator()
@@ -0,0 +1,232 @@
+package badges
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RequirementsDiff is the difference between two versions of the badge requirements
+type RequirementsDiff struct {
+	// Added and Removed list badges present in only one version, by category then name
+	Added   []Badge
+	Removed []Badge
+	// Changed lists badges present in both versions whose requirements differ
+	Changed []BadgeDiff
+}
+
+// Empty reports whether the two versions define the same badges and requirements
+func (d *RequirementsDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// BadgeDiff is how one badge's requirements changed
+type BadgeDiff struct {
+	// ID is the badge ID and Name its name in the new version
+	ID   string
+	Name string
+	// Fields lists metadata changes such as "Category: Defense → Rebounding"
+	Fields []string
+	// Thresholds lists changed tier thresholds of requirements present in both versions
+	Thresholds []ThresholdChange
+	// Heights lists requirements whose height restriction changed
+	Heights []HeightChange
+	// AddedRequirements and RemovedRequirements list requirement rows present in only one version
+	AddedRequirements   []AttributeRequirement
+	RemovedRequirements []AttributeRequirement
+}
+
+// ThresholdChange is one tier threshold of one requirement before and after (0 = tier unavailable)
+type ThresholdChange struct {
+	Attribute string
+	Tier      BadgeTier
+	From      int
+	To        int
+}
+
+// String formats the change as "Driving Dunk Gold: 80 → 83"
+func (t ThresholdChange) String() string {
+	return fmt.Sprintf("%s %s: %s → %s", t.Attribute, t.Tier, thresholdString(t.From), thresholdString(t.To))
+}
+
+// HeightChange is a requirement's height restriction before and after (0 = unbounded)
+type HeightChange struct {
+	Attribute string
+	FromMin   int
+	FromMax   int
+	ToMin     int
+	ToMax     int
+}
+
+// String formats the change as "Block: any height → 6'10"-7'4""
+func (h HeightChange) String() string {
+	return fmt.Sprintf("%s: %s → %s", h.Attribute, bandString(h.FromMin, h.FromMax), bandString(h.ToMin, h.ToMax))
+}
+
+// DiffRequirements compares the badges and requirements of two calculators
+// Requirement rows are matched by attribute, group, and height band. A row whose
+// band moved is reported as a height change when it is the only unmatched row for
+// its attribute and group in each version.
+func DiffRequirements(old, new *Calculator) *RequirementsDiff {
+	diff := &RequirementsDiff{}
+
+	for _, b := range new.Badges() {
+		prev, exists := old.badges[b.ID]
+		if !exists {
+			diff.Added = append(diff.Added, b)
+			continue
+		}
+		if bd := diffBadge(prev, new.badges[b.ID]); bd != nil {
+			diff.Changed = append(diff.Changed, *bd)
+		}
+	}
+	for _, b := range old.Badges() {
+		if _, exists := new.badges[b.ID]; !exists {
+			diff.Removed = append(diff.Removed, b)
+		}
+	}
+
+	return diff
+}
+
+// diffBadge compares one badge in two versions (nil if nothing changed)
+func diffBadge(old, new *Badge) *BadgeDiff {
+	bd := &BadgeDiff{ID: new.ID, Name: new.Name}
+
+	field := func(name string, from, to any) {
+		if from != to {
+			bd.Fields = append(bd.Fields, fmt.Sprintf("%s: %v → %v", name, from, to))
+		}
+	}
+	field("Name", old.Name, new.Name)
+	field("Category", old.Category, new.Category)
+	field("Type", old.Type, new.Type)
+
+	// Match rows on attribute, group, and band; leftovers may be band moves
+	matched := make([]bool, len(new.Requirements))
+	var removed []AttributeRequirement
+	for _, o := range old.Requirements {
+		found := false
+		for i, n := range new.Requirements {
+			if !matched[i] && requirementKey(o, true) == requirementKey(n, true) {
+				matched[i], found = true, true
+				bd.compare(o, n)
+				break
+			}
+		}
+		if !found {
+			removed = append(removed, o)
+		}
+	}
+	var added []AttributeRequirement
+	for i, n := range new.Requirements {
+		if !matched[i] {
+			added = append(added, n)
+		}
+	}
+
+	for _, o := range removed {
+		key := requirementKey(o, false)
+		if countKey(removed, key) != 1 || countKey(added, key) != 1 {
+			bd.RemovedRequirements = append(bd.RemovedRequirements, o)
+			continue
+		}
+		for _, n := range added {
+			if requirementKey(n, false) == key {
+				bd.Heights = append(bd.Heights, HeightChange{
+					Attribute: o.Attribute,
+					FromMin:   o.MinHeight,
+					FromMax:   o.MaxHeight,
+					ToMin:     n.MinHeight,
+					ToMax:     n.MaxHeight,
+				})
+				bd.compare(o, n)
+			}
+		}
+	}
+	for _, n := range added {
+		key := requirementKey(n, false)
+		if countKey(removed, key) != 1 || countKey(added, key) != 1 {
+			bd.AddedRequirements = append(bd.AddedRequirements, n)
+		}
+	}
+
+	if len(bd.Fields) == 0 && len(bd.Thresholds) == 0 && len(bd.Heights) == 0 &&
+		len(bd.AddedRequirements) == 0 && len(bd.RemovedRequirements) == 0 {
+		return nil
+	}
+	return bd
+}
+
+// compare records the threshold and type changes between two matched rows
+func (bd *BadgeDiff) compare(old, new AttributeRequirement) {
+	for t := BadgeTierBronze; t <= BadgeTierLegendary; t++ {
+		if from, to := old.Threshold(t), new.Threshold(t); from != to {
+			bd.Thresholds = append(bd.Thresholds, ThresholdChange{Attribute: new.Attribute, Tier: t, From: from, To: to})
+		}
+	}
+	if old.Type != new.Type && old.Type != "" && new.Type != "" {
+		bd.Fields = append(bd.Fields, fmt.Sprintf("%s type: %s → %s", new.Attribute, old.Type, new.Type))
+	}
+	if old.GroupType != new.GroupType {
+		bd.Fields = append(bd.Fields, fmt.Sprintf("%s group type: %q → %q", new.Attribute, old.GroupType, new.GroupType))
+	}
+}
+
+// requirementKey identifies a requirement row by attribute and group, plus its band if withBand
+func requirementKey(r AttributeRequirement, withBand bool) string {
+	key := canonicalAttribute(r.Attribute) + "|" + r.Group
+	if withBand {
+		key += fmt.Sprintf("|%d-%d", r.MinHeight, r.MaxHeight)
+	}
+	return key
+}
+
+// countKey counts the rows with a band-less requirement key
+func countKey(reqs []AttributeRequirement, key string) int {
+	n := 0
+	for _, r := range reqs {
+		if requirementKey(r, false) == key {
+			n++
+		}
+	}
+	return n
+}
+
+// thresholdString formats a threshold, showing an unavailable tier as "—"
+func thresholdString(v int) string {
+	if v == 0 {
+		return "—"
+	}
+	return fmt.Sprint(v)
+}
+
+// bandString formats a height band such as 6'10"-7'4", 6'10"+, up to 7'4", or any height
+func bandString(minHeight, maxHeight int) string {
+	feet := func(inches int) string { return fmt.Sprintf("%d'%d\"", inches/12, inches%12) }
+	switch {
+	case minHeight == 0 && maxHeight == 0:
+		return "any height"
+	case maxHeight == 0:
+		return feet(minHeight) + "+"
+	case minHeight == 0:
+		return "up to " + feet(maxHeight)
+	default:
+		return feet(minHeight) + "-" + feet(maxHeight)
+	}
+}
+
+// String formats a requirement row as "Block 60/70/80/90/95 (6'10"+)"
+func (r AttributeRequirement) String() string {
+	tiers := make([]string, 0, 5)
+	for t := BadgeTierBronze; t <= BadgeTierLegendary; t++ {
+		tiers = append(tiers, thresholdString(r.Threshold(t)))
+	}
+	s := r.Attribute + " " + strings.Join(tiers, "/")
+	if r.MinHeight > 0 || r.MaxHeight > 0 {
+		s += " (" + bandString(r.MinHeight, r.MaxHeight) + ")"
+	}
+	if r.Group != "" {
+		s += " [" + r.Group + "]"
+	}
+	return s
+}
@@ -0,0 +1,74 @@
+package badges_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const diffOldSheet = `[
+	{"Category": "Defense", "Badge": "Glove", "Type": "Primary", "Attribute": "Steal", "Bronze": 60, "Silver": 70, "Gold": 80, "HoF": 90, "Legend": 95, "id": "Glove"},
+	{"Category": "Defense", "Badge": "Paint Patroller", "Type": "Primary", "Attribute": "Block", "Bronze": 60, "Silver": 70, "Gold": 80, "HoF": 90, "Legend": 95, "Min_Height": "6'8", "Max_Height": "7'4", "id": "PaintPatroller"},
+	{"Category": "Defense", "Badge": "Paint Patroller", "Type": "Primary", "Attribute": "Vertical", "Bronze": 50, "Silver": 60, "Gold": 70, "HoF": 80, "Legend": 90, "id": "PaintPatroller"},
+	{"Category": "Playmaking", "Badge": "Dimer", "Type": "Primary", "Attribute": "Pass Accuracy", "Bronze": 60, "Silver": 70, "Gold": 80, "HoF": 90, "Legend": 95, "id": "Dimer"}
+]`
+
+const diffNewSheet = `[
+	{"Category": "Defense", "Badge": "Glove", "Type": "Primary", "Attribute": "Steal", "Bronze": 60, "Silver": 72, "Gold": 80, "HoF": 90, "Legend": "", "id": "Glove"},
+	{"Category": "Defense", "Badge": "Paint Patroller", "Type": "Primary", "Attribute": "Block", "Bronze": 60, "Silver": 70, "Gold": 80, "HoF": 90, "Legend": 95, "Min_Height": "6'10", "Max_Height": "7'4", "id": "PaintPatroller"},
+	{"Category": "Defense", "Badge": "Paint Patroller", "Type": "Primary", "Attribute": "Interior Defense", "Bronze": 55, "Silver": 65, "Gold": 75, "HoF": 85, "Legend": 92, "id": "PaintPatroller"},
+	{"Category": "Rebounding", "Badge": "Boxout Beast", "Type": "Primary", "Attribute": "Strength", "Bronze": 60, "Silver": 70, "Gold": 80, "HoF": 90, "Legend": 95, "id": "BoxoutBeast"}
+]`
+
+func diffCalculators(t *testing.T) (*badges.Calculator, *badges.Calculator) {
+	t.Helper()
+	old, err := badges.NewCalculator(badges.WithRequirementsReader(strings.NewReader(diffOldSheet)))
+	require.NoError(t, err)
+	updated, err := badges.NewCalculator(badges.WithRequirementsReader(strings.NewReader(diffNewSheet)))
+	require.NoError(t, err)
+	return old, updated
+}
+
+// TestDiffRequirements tests added, removed, and changed badges are reported
+func TestDiffRequirements(t *testing.T) {
+	old, updated := diffCalculators(t)
+	diff := badges.DiffRequirements(old, updated)
+	assert.False(t, diff.Empty())
+
+	require.Len(t, diff.Added, 1)
+	assert.Equal(t, "Boxout Beast", diff.Added[0].Name)
+	require.Len(t, diff.Removed, 1)
+	assert.Equal(t, "Dimer", diff.Removed[0].Name)
+
+	require.Len(t, diff.Changed, 2)
+
+	glove := diff.Changed[0]
+	assert.Equal(t, "Glove", glove.Name)
+	assert.Equal(t, []badges.ThresholdChange{
+		{Attribute: "Steal", Tier: badges.BadgeTierSilver, From: 70, To: 72},
+		{Attribute: "Steal", Tier: badges.BadgeTierLegendary, From: 95, To: 0},
+	}, glove.Thresholds)
+	assert.Equal(t, "Steal Legendary: 95 → —", glove.Thresholds[1].String())
+
+	patroller := diff.Changed[1]
+	assert.Equal(t, "Paint Patroller", patroller.Name)
+	assert.Empty(t, patroller.Thresholds)
+	require.Len(t, patroller.Heights, 1)
+	assert.Equal(t, `Block: 6'8"-7'4" → 6'10"-7'4"`, patroller.Heights[0].String())
+	require.Len(t, patroller.AddedRequirements, 1)
+	assert.Equal(t, "Interior Defense", patroller.AddedRequirements[0].Attribute)
+	require.Len(t, patroller.RemovedRequirements, 1)
+	assert.Equal(t, "Vertical 50/60/70/80/90", patroller.RemovedRequirements[0].String())
+}
+
+// TestDiffRequirements_Same tests identical data produces an empty diff
+func TestDiffRequirements_Same(t *testing.T) {
+	old, _ := diffCalculators(t)
+	same, err := badges.NewCalculator(badges.WithRequirementsReader(strings.NewReader(diffOldSheet)))
+	require.NoError(t, err)
+
+	assert.True(t, badges.DiffRequirements(old, same).Empty())
+}
@@ -0,0 +1,230 @@
+package badges
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// RequirementExplanation describes how one attribute requirement is met
+type RequirementExplanation struct {
+	// Attribute is the requirement's attribute name
+	Attribute string
+	// Value is the build's current value for the attribute
+	Value int
+	// Thresholds holds the minimum value per tier, indexed by BadgeTier (0 if the tier is unavailable)
+	Thresholds [BadgeTierLegendary + 1]int
+	// Tier is the highest tier this attribute alone meets
+	Tier BadgeTier
+	// PointsToNext is how many points this attribute needs for the badge's next tier
+	// (0 if already met, -1 if the attribute has no threshold for that tier)
+	PointsToNext int
+	// MinHeight and MaxHeight are the height band of the row applied (0 if unrestricted)
+	MinHeight int
+	MaxHeight int
+}
+
+// BadgeExplanation describes why a build earns a badge tier
+type BadgeExplanation struct {
+	// Name is the badge name
+	Name string
+	// Category is the badge category
+	Category BadgeCategory
+	// Type is Primary (all requirements) or Secondary (any requirement)
+	Type BadgeType
+	// Tier is the tier the build earns
+	Tier BadgeTier
+	// Branch lists the attributes that met Tier (see BadgeEvaluation)
+	Branch []string
+	// HeightAllowed is false if the build's height is outside the badge's height range
+	HeightAllowed bool
+	// MinHeight and MaxHeight are the badge's height range in inches (0 if unrestricted)
+	MinHeight int
+	MaxHeight int
+	// Requirements explains each attribute requirement
+	Requirements []RequirementExplanation
+	// Bottleneck is the attribute holding a Primary badge back: the one furthest
+	// short on the cheapest way to NextTier
+	Bottleneck string
+	// BestPath is the attributes earning a Secondary badge its tier joined with
+	// " + " (the cheapest way to NextTier if the badge has no tier yet)
+	BestPath string
+	// NextTier is the next tier above Tier (None if already Legendary or out of reach)
+	NextTier BadgeTier
+	// PointsToNextTier is the fewest attribute points needed to reach NextTier,
+	// following the requirement tree (see NextTierGaps)
+	PointsToNextTier int
+	// NextTierGaps are the attributes the cheapest way to NextTier needs raised,
+	// most points first
+	NextTierGaps []AttributeGap
+}
+
+// Threshold returns the minimum attribute value for a tier (0 if unavailable)
+func (r AttributeRequirement) Threshold(tier BadgeTier) int {
+	switch tier {
+	case BadgeTierBronze:
+		return r.Bronze
+	case BadgeTierSilver:
+		return r.Silver
+	case BadgeTierGold:
+		return r.Gold
+	case BadgeTierHallOfFame:
+		return r.HallOfFame
+	case BadgeTierLegendary:
+		return r.Legendary
+	default:
+		return 0
+	}
+}
+
+// ExplainBadge explains a badge's tier for a build: every requirement's value and
+// thresholds, the bottleneck or best path, and the points needed for the next tier
+// The next tier is costed over the badge's requirement tree, so with nested groups
+// only the cheapest branch counts ("Driving Dunk AND (Vertical OR Strength)" needs
+// Driving Dunk plus whichever of Vertical or Strength is closer).
+func (c *Calculator) ExplainBadge(badgeName string, attrs *scraper.AttributeCaps) (*BadgeExplanation, error) {
+	badge, err := c.Badge(badgeName)
+	if err != nil {
+		return nil, err
+	}
+
+	eval, err := c.EvaluateBadge(badgeName, attrs)
+	if err != nil {
+		return nil, err
+	}
+	tier := eval.Tier
+
+	exp := &BadgeExplanation{
+		Name:          badge.Name,
+		Category:      badge.Category,
+		Type:          badge.Type,
+		Tier:          tier,
+		Branch:        eval.Branches[tier],
+		HeightAllowed: true,
+	}
+
+	exp.MinHeight, exp.MaxHeight = heightRange(badge.Requirements)
+	applicable, ok := applicableRequirements(badge.Requirements, attrs.Height)
+	if !ok {
+		exp.HeightAllowed = false
+		// Show the widest band of each attribute so the thresholds are still visible
+		applicable = widestRequirements(badge.Requirements)
+	}
+
+	if exp.HeightAllowed && tier < BadgeTierLegendary {
+		exp.NextTier = tier + 1
+	}
+
+	for _, req := range applicable {
+		value := c.getAttributeValue(req.Attribute, attrs)
+		r := RequirementExplanation{
+			Attribute: req.Attribute,
+			Value:     value,
+			Tier:      c.getTierForRequirement(req, value),
+			MinHeight: req.MinHeight,
+			MaxHeight: req.MaxHeight,
+		}
+		for t := BadgeTierBronze; t <= BadgeTierLegendary; t++ {
+			r.Thresholds[t] = req.Threshold(t)
+		}
+
+		if exp.NextTier != BadgeTierNone {
+			switch threshold := req.Threshold(exp.NextTier); {
+			case threshold == 0:
+				r.PointsToNext = -1
+			case value < threshold:
+				r.PointsToNext = threshold - value
+			}
+		}
+
+		exp.Requirements = append(exp.Requirements, r)
+	}
+
+	if len(exp.Requirements) == 0 {
+		return exp, nil
+	}
+
+	var path requirementSet
+	if exp.NextTier != BadgeTierNone {
+		path = c.explainNextTier(exp, badge.Tree, attrs)
+	}
+	if badge.Type == BadgeTypePrimary {
+		if len(exp.NextTierGaps) > 0 {
+			exp.Bottleneck = exp.NextTierGaps[0].Attribute
+		}
+	} else {
+		exp.BestPath = eval.Branch(tier)
+		if exp.BestPath == "" {
+			exp.BestPath = strings.Join(slices.Sorted(maps.Keys(path)), " + ")
+		}
+	}
+
+	return exp, nil
+}
+
+// heightRange returns the heights a badge is available at: for each attribute the
+// union of its bands, intersected across attributes (0 means unrestricted)
+func heightRange(requirements []AttributeRequirement) (minHeight, maxHeight int) {
+	type band struct{ min, max int }
+	bands := make(map[string]band)
+	for _, req := range requirements {
+		b, seen := bands[req.Attribute]
+		if !seen {
+			bands[req.Attribute] = band{req.MinHeight, req.MaxHeight}
+			continue
+		}
+		if req.MinHeight < b.min {
+			b.min = req.MinHeight
+		}
+		if b.max != 0 && (req.MaxHeight == 0 || req.MaxHeight > b.max) {
+			b.max = req.MaxHeight
+		}
+		bands[req.Attribute] = b
+	}
+
+	for _, b := range bands {
+		if b.min > minHeight {
+			minHeight = b.min
+		}
+		if b.max != 0 && (maxHeight == 0 || b.max < maxHeight) {
+			maxHeight = b.max
+		}
+	}
+	return minHeight, maxHeight
+}
+
+// widestRequirements picks the widest height band row for each attribute
+func widestRequirements(requirements []AttributeRequirement) []AttributeRequirement {
+	var widest []AttributeRequirement
+	index := make(map[string]int)
+	for _, req := range requirements {
+		i, seen := index[req.Attribute]
+		switch {
+		case !seen:
+			index[req.Attribute] = len(widest)
+			widest = append(widest, req)
+		case req.bandWidth() > widest[i].bandWidth():
+			widest[i] = req
+		}
+	}
+	return widest
+}
+
+// explainNextTier costs the cheapest way through the requirement tree to NextTier
+// and returns it, clearing NextTier if no way reaches it
+func (c *Calculator) explainNextTier(exp *BadgeExplanation, tree *RequirementGroup, attrs *scraper.AttributeCaps) requirementSet {
+	var paths []requirementSet
+	if tree != nil {
+		paths = c.alternatives(tree, exp.NextTier, attrs.Height)
+	}
+	path, cost := cheapestPath(paths, attrs)
+	if cost < 0 {
+		exp.NextTier = BadgeTierNone
+		return nil
+	}
+	exp.PointsToNextTier = cost
+	exp.NextTierGaps = pathGaps(path, attrs)
+	return path
+}
@@ -0,0 +1,120 @@
+package badges_test
+
+import (
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExplainBadge_Primary tests the bottleneck and points to next tier for an all-of badge
+// Posterizer: Silver needs DD 87+ and Vert 75+
+func TestExplainBadge_Primary(t *testing.T) {
+	calc, err := badges.NewCalculator()
+	require.NoError(t, err)
+
+	attrs := &scraper.AttributeCaps{Height: 84, DrivingDunk: 90, Vertical: 70}
+
+	exp, err := calc.ExplainBadge("Posterizer", attrs)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Posterizer", exp.Name)
+	assert.Equal(t, badges.BadgeTypePrimary, exp.Type)
+	assert.Equal(t, badges.BadgeCategoryFinishing, exp.Category)
+	assert.True(t, exp.HeightAllowed)
+	assert.Equal(t, badges.BadgeTierBronze, exp.Tier)
+	assert.Equal(t, "Vertical", exp.Bottleneck)
+	assert.Empty(t, exp.BestPath)
+	assert.Equal(t, badges.BadgeTierSilver, exp.NextTier)
+	assert.Equal(t, 5, exp.PointsToNextTier, "Vertical 70 → 75, Driving Dunk already 87+")
+
+	require.Len(t, exp.Requirements, 2)
+	dunk := exp.Requirements[0]
+	assert.Equal(t, "Driving Dunk", dunk.Attribute)
+	assert.Equal(t, 90, dunk.Value)
+	assert.Equal(t, badges.BadgeTierSilver, dunk.Tier)
+	assert.Equal(t, [6]int{0, 73, 87, 93, 96, 99}, dunk.Thresholds)
+	assert.Equal(t, 0, dunk.PointsToNext)
+	assert.Equal(t, 5, exp.Requirements[1].PointsToNext)
+}
+
+// TestExplainBadge_PrimarySumsShortfalls tests that every short requirement counts toward the next tier
+func TestExplainBadge_PrimarySumsShortfalls(t *testing.T) {
+	calc, err := badges.NewCalculator()
+	require.NoError(t, err)
+
+	attrs := &scraper.AttributeCaps{Height: 84, DrivingDunk: 70, Vertical: 60}
+
+	exp, err := calc.ExplainBadge("Posterizer", attrs)
+	require.NoError(t, err)
+	assert.Equal(t, badges.BadgeTierNone, exp.Tier)
+	assert.Equal(t, badges.BadgeTierBronze, exp.NextTier)
+	assert.Equal(t, 8, exp.PointsToNextTier, "DD 70 → 73 plus Vert 60 → 65")
+	assert.Equal(t, "Vertical", exp.Bottleneck, "both at None; Vertical is further from Bronze")
+}
+
+// TestExplainBadge_Secondary tests the best path and the closest requirement for an any-of badge
+// Deadeye: Mid-Range OR Three-Point, Gold at 92, HoF at 95
+func TestExplainBadge_Secondary(t *testing.T) {
+	calc, err := badges.NewCalculator()
+	require.NoError(t, err)
+
+	attrs := &scraper.AttributeCaps{Height: 84, MidRangeShot: 80, ThreePointShot: 93}
+
+	exp, err := calc.ExplainBadge("Deadeye", attrs)
+	require.NoError(t, err)
+	assert.Equal(t, badges.BadgeTierGold, exp.Tier)
+	assert.Equal(t, "Three-Point Shot", exp.BestPath)
+	assert.Empty(t, exp.Bottleneck)
+	assert.Equal(t, badges.BadgeTierHallOfFame, exp.NextTier)
+	assert.Equal(t, 2, exp.PointsToNextTier, "Three-Point 93 → 95")
+}
+
+// TestExplainBadge_Height tests badges the build is too short for
+func TestExplainBadge_Height(t *testing.T) {
+	calc, err := badges.NewCalculator()
+	require.NoError(t, err)
+
+	attrs := &scraper.AttributeCaps{Height: 75, StandingDunk: 99, Vertical: 99}
+
+	exp, err := calc.ExplainBadge("Rise Up", attrs)
+	require.NoError(t, err)
+	assert.False(t, exp.HeightAllowed)
+	assert.Equal(t, badges.BadgeTierNone, exp.Tier)
+	assert.Equal(t, 78, exp.MinHeight)
+	assert.Equal(t, 88, exp.MaxHeight)
+	assert.Equal(t, badges.BadgeTierNone, exp.NextTier, "no amount of points helps")
+}
+
+// TestExplainBadge_Legendary tests that maxed badges have no next tier
+func TestExplainBadge_Legendary(t *testing.T) {
+	calc, err := badges.NewCalculator()
+	require.NoError(t, err)
+
+	attrs := &scraper.AttributeCaps{Height: 84, DrivingDunk: 99, Vertical: 99}
+
+	exp, err := calc.ExplainBadge("Posterizer", attrs)
+	require.NoError(t, err)
+	assert.Equal(t, badges.BadgeTierLegendary, exp.Tier)
+	assert.Equal(t, badges.BadgeTierNone, exp.NextTier)
+	assert.Equal(t, 0, exp.PointsToNextTier)
+}
+
+// TestExplainBadge_HyphenatedName tests badges whose IDs keep their hyphens
+func TestExplainBadge_HyphenatedName(t *testing.T) {
+	calc, err := badges.NewCalculator()
+	require.NoError(t, err)
+
+	attrs := &scraper.AttributeCaps{Height: 84, PostControl: 90}
+
+	for _, name := range []string{"Post-Up Poet", "Post-UpPoet", "post up poet"} {
+		exp, err := calc.ExplainBadge(name, attrs)
+		require.NoError(t, err, name)
+		assert.Equal(t, badges.BadgeTierGold, exp.Tier, name)
+	}
+
+	_, err = calc.ExplainBadge("NonexistentBadge", attrs)
+	assert.Error(t, err)
+}
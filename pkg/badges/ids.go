@@ -0,0 +1,110 @@
+package badges
+
+//go:generate go run ./internal/genids -in data/badge_requirements.json -out ids_gen.go
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// BadgeID is a badge identifier from the NBA2KLab data (e.g., "Post-UpPoet")
+// The constants in ids_gen.go are generated from data/badge_requirements.json;
+// run go generate after updating the data.
+type BadgeID string
+
+// String returns the ID, so it can be passed anywhere a badge name is accepted
+func (id BadgeID) String() string {
+	return string(id)
+}
+
+// NotFoundError reports a badge name that matched no badge, with the closest names
+type NotFoundError struct {
+	// Name is the name that was looked up
+	Name string
+	// Suggestions are the closest badge names, best first (may be empty)
+	Suggestions []string
+}
+
+func (e *NotFoundError) Error() string {
+	if len(e.Suggestions) == 0 {
+		return fmt.Sprintf("badge %q not found", e.Name)
+	}
+	return fmt.Sprintf("badge %q not found (did you mean %s?)", e.Name, strings.Join(e.Suggestions, ", "))
+}
+
+// maxSuggestions is how many names a NotFoundError suggests at most
+const maxSuggestions = 3
+
+// notFound builds the error for a failed lookup, suggesting the closest badge names
+// A name is suggested if it starts with or contains the query, or is within a
+// few edits of it (about one per three characters).
+func (c *Calculator) notFound(badgeName string) error {
+	key := normalizeBadgeName(badgeName)
+
+	type match struct {
+		name     string
+		distance int
+	}
+	var matches []match
+	for _, b := range c.badges {
+		name := normalizeBadgeName(b.Name)
+		d := editDistance(key, name)
+		switch {
+		case key != "" && strings.HasPrefix(name, key):
+			d = 0
+		case key != "" && strings.Contains(name, key):
+			d = min(d, 1)
+		case d > max(2, len(key)/3):
+			continue
+		}
+		matches = append(matches, match{b.Name, d})
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].name < matches[j].name
+	})
+
+	err := &NotFoundError{Name: badgeName}
+	for i := 0; i < len(matches) && i < maxSuggestions; i++ {
+		err.Suggestions = append(err.Suggestions, matches[i].name)
+	}
+	return err
+}
+
+// normalizeBadgeName keeps only the letters and digits of a badge name, lowercased
+func normalizeBadgeName(name string) string {
+	var sb strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return sb.String()
+}
+
+// editDistance returns the Levenshtein distance between two strings
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,131 @@
+// Code generated by genids from data/badge_requirements.json; DO NOT EDIT.
+
+package badges
+
+// Badge IDs in the NBA2KLab requirements data
+const (
+	// BadgeAerialWizard is the Aerial Wizard badge
+	BadgeAerialWizard BadgeID = "AerialWizard"
+	// BadgeAnkleAssassin is the Ankle Assassin badge
+	BadgeAnkleAssassin BadgeID = "AnkleAssassin"
+	// BadgeBailOut is the Bail Out badge
+	BadgeBailOut BadgeID = "BailOut"
+	// BadgeBoxoutBeast is the Boxout Beast badge
+	BadgeBoxoutBeast BadgeID = "BoxoutBeast"
+	// BadgeBreakStarter is the Break Starter badge
+	BadgeBreakStarter BadgeID = "BreakStarter"
+	// BadgeBrickWall is the Brick Wall badge
+	BadgeBrickWall BadgeID = "BrickWall"
+	// BadgeChallenger is the Challenger badge
+	BadgeChallenger BadgeID = "Challenger"
+	// BadgeDeadeye is the Deadeye badge
+	BadgeDeadeye BadgeID = "Deadeye"
+	// BadgeDimer is the Dimer badge
+	BadgeDimer BadgeID = "Dimer"
+	// BadgeFloatGame is the Float Game badge
+	BadgeFloatGame BadgeID = "FloatGame"
+	// BadgeGlove is the Glove badge
+	BadgeGlove BadgeID = "Glove"
+	// BadgeHandlesForDays is the Handles For Days badge
+	BadgeHandlesForDays BadgeID = "HandlesForDays"
+	// BadgeHighFlyingDenier is the High-Flying Denier badge
+	BadgeHighFlyingDenier BadgeID = "High-FlyingDenier"
+	// BadgeHookSpecialist is the Hook Specialist badge
+	BadgeHookSpecialist BadgeID = "HookSpecialist"
+	// BadgeImmovableEnforcer is the Immovable Enforcer badge
+	BadgeImmovableEnforcer BadgeID = "ImmovableEnforcer"
+	// BadgeInterceptor is the Interceptor badge
+	BadgeInterceptor BadgeID = "Interceptor"
+	// BadgeLayupMixmaster is the Layup Mixmaster badge
+	BadgeLayupMixmaster BadgeID = "LayupMixmaster"
+	// BadgeLightningLaunch is the Lightning Launch badge
+	BadgeLightningLaunch BadgeID = "LightningLaunch"
+	// BadgeLimitlessRange is the Limitless Range badge
+	BadgeLimitlessRange BadgeID = "LimitlessRange"
+	// BadgeMiniMarksman is the Mini Marksman badge
+	BadgeMiniMarksman BadgeID = "MiniMarksman"
+	// BadgeOffBallPest is the Off-Ball Pest badge
+	BadgeOffBallPest BadgeID = "Off-BallPest"
+	// BadgeOnBallMenace is the On-Ball Menace badge
+	BadgeOnBallMenace BadgeID = "On-BallMenace"
+	// BadgePaintPatroller is the Paint Patroller badge
+	BadgePaintPatroller BadgeID = "PaintPatroller"
+	// BadgePaintProdigy is the Paint Prodigy badge
+	BadgePaintProdigy BadgeID = "PaintProdigy"
+	// BadgePhyiscalFinisher is the Phyiscal Finisher badge
+	BadgePhyiscalFinisher BadgeID = "PhyiscalFinisher"
+	// BadgePickDodger is the Pick Dodger badge
+	BadgePickDodger BadgeID = "PickDodger"
+	// BadgePogoStick is the Pogo Stick badge
+	BadgePogoStick BadgeID = "PogoStick"
+	// BadgePostFadePhenom is the Post Fade Phenom badge
+	BadgePostFadePhenom BadgeID = "PostFadePhenom"
+	// BadgePostLockdown is the Post Lockdown badge
+	BadgePostLockdown BadgeID = "PostLockdown"
+	// BadgePostPowerhouse is the Post Powerhouse badge
+	BadgePostPowerhouse BadgeID = "PostPowerhouse"
+	// BadgePostUpPoet is the Post-Up Poet badge
+	BadgePostUpPoet BadgeID = "Post-UpPoet"
+	// BadgePosterizer is the Posterizer badge
+	BadgePosterizer BadgeID = "Posterizer"
+	// BadgeReboundChaser is the Rebound Chaser badge
+	BadgeReboundChaser BadgeID = "ReboundChaser"
+	// BadgeRiseUp is the Rise Up badge
+	BadgeRiseUp BadgeID = "RiseUp"
+	// BadgeSetShotSpecialist is the Set Shot Specialist badge
+	BadgeSetShotSpecialist BadgeID = "SetShotSpecialist"
+	// BadgeShiftyShooter is the Shifty Shooter badge
+	BadgeShiftyShooter BadgeID = "ShiftyShooter"
+	// BadgeSlipperyOffBall is the Slippery Off-Ball badge
+	BadgeSlipperyOffBall BadgeID = "SlipperyOff-Ball"
+	// BadgeStrongHandle is the Strong Handle badge
+	BadgeStrongHandle BadgeID = "StrongHandle"
+	// BadgeUnpluckable is the Unpluckable badge
+	BadgeUnpluckable BadgeID = "Unpluckable"
+	// BadgeVersatileVisionary is the Versatile Visionary badge
+	BadgeVersatileVisionary BadgeID = "VersatileVisionary"
+)
+
+// AllBadgeIDs lists every badge ID in the requirements data
+var AllBadgeIDs = []BadgeID{
+	BadgeAerialWizard,
+	BadgeAnkleAssassin,
+	BadgeBailOut,
+	BadgeBoxoutBeast,
+	BadgeBreakStarter,
+	BadgeBrickWall,
+	BadgeChallenger,
+	BadgeDeadeye,
+	BadgeDimer,
+	BadgeFloatGame,
+	BadgeGlove,
+	BadgeHandlesForDays,
+	BadgeHighFlyingDenier,
+	BadgeHookSpecialist,
+	BadgeImmovableEnforcer,
+	BadgeInterceptor,
+	BadgeLayupMixmaster,
+	BadgeLightningLaunch,
+	BadgeLimitlessRange,
+	BadgeMiniMarksman,
+	BadgeOffBallPest,
+	BadgeOnBallMenace,
+	BadgePaintPatroller,
+	BadgePaintProdigy,
+	BadgePhyiscalFinisher,
+	BadgePickDodger,
+	BadgePogoStick,
+	BadgePostFadePhenom,
+	BadgePostLockdown,
+	BadgePostPowerhouse,
+	BadgePostUpPoet,
+	BadgePosterizer,
+	BadgeReboundChaser,
+	BadgeRiseUp,
+	BadgeSetShotSpecialist,
+	BadgeShiftyShooter,
+	BadgeSlipperyOffBall,
+	BadgeStrongHandle,
+	BadgeUnpluckable,
+	BadgeVersatileVisionary,
+}
@@ -0,0 +1,69 @@
+package badges_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAllBadgeIDs tests the generated IDs match the embedded data
+func TestAllBadgeIDs(t *testing.T) {
+	calc, err := badges.NewCalculator()
+	require.NoError(t, err)
+
+	assert.Len(t, badges.AllBadgeIDs, len(calc.Badges()))
+	for _, id := range badges.AllBadgeIDs {
+		b, err := calc.Badge(id.String())
+		require.NoError(t, err, id)
+		assert.Equal(t, string(id), b.ID)
+	}
+
+	b, err := calc.Badge(badges.BadgePostUpPoet.String())
+	require.NoError(t, err)
+	assert.Equal(t, "Post-Up Poet", b.Name)
+}
+
+// TestLookup_CaseAndPunctuation tests names match regardless of casing and punctuation
+func TestLookup_CaseAndPunctuation(t *testing.T) {
+	calc, err := badges.NewCalculator()
+	require.NoError(t, err)
+
+	for _, name := range []string{"Post-Up Poet", "post up poet", "POSTUP-POET", "post_up.poet", "Post-UpPoet"} {
+		b, err := calc.Badge(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, "Post-Up Poet", b.Name, name)
+	}
+}
+
+// TestLookup_Suggestions tests a miss returns the closest badge names
+func TestLookup_Suggestions(t *testing.T) {
+	calc, err := badges.NewCalculator()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		suggestions []string
+	}{
+		{"Posterize", []string{"Posterizer"}},
+		{"Dimmer", []string{"Dimer"}},
+		{"paint", []string{"Paint Patroller", "Paint Prodigy"}},
+		{"xyzzy", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calc.GetBadgeTier(tt.name, &scraper.AttributeCaps{})
+			var notFound *badges.NotFoundError
+			require.True(t, errors.As(err, &notFound))
+			assert.Equal(t, tt.name, notFound.Name)
+			assert.Equal(t, tt.suggestions, notFound.Suggestions)
+		})
+	}
+
+	_, err = calc.Badge("Posterize")
+	assert.EqualError(t, err, `badge "Posterize" not found (did you mean Posterizer?)`)
+}
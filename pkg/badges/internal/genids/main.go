@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+// Command genids generates the badges package's BadgeID constants from the
+// NBA2KLab requirements JSON. Run it with go generate in pkg/badges.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// row is the part of a requirements row the generator needs
+type row struct {
+	Badge string `json:"Badge"`
+	ID    string `json:"id"`
+}
+
+func main() {
+	var (
+		in  = flag.String("in", "data/badge_requirements.json", "Badge requirements JSON")
+		out = flag.String("out", "ids_gen.go", "Generated Go file")
+	)
+	flag.Parse()
+
+	data, err := os.ReadFile(*in)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading requirements: %v\n", err)
+		os.Exit(1)
+	}
+
+	src, err := generate(*in, data)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error generating IDs: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", *out, err)
+		os.Exit(1)
+	}
+}
+
+// generate renders the constants for every badge ID in the requirements data
+func generate(in string, data []byte) ([]byte, error) {
+	var rows []row
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", in, err)
+	}
+
+	names := make(map[string]string) // ID → badge name
+	for _, r := range rows {
+		if _, seen := names[r.ID]; !seen {
+			names[r.ID] = r.Badge
+		}
+	}
+
+	type constant struct{ ident, id, name string }
+	var consts []constant
+	idents := make(map[string]string) // identifier → ID
+	for id, name := range names {
+		// Name the constant after the badge name, since IDs may contain hyphens
+		ident := "Badge" + identifier(name)
+		if other, dup := idents[ident]; dup {
+			return nil, fmt.Errorf("badges %q and %q both generate %s", other, id, ident)
+		}
+		idents[ident] = id
+		consts = append(consts, constant{ident, id, name})
+	}
+	sort.Slice(consts, func(i, j int) bool { return consts[i].ident < consts[j].ident })
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by genids from %s; DO NOT EDIT.\n\n", in)
+	buf.WriteString("package badges\n\n")
+	buf.WriteString("// Badge IDs in the NBA2KLab requirements data\n")
+	buf.WriteString("const (\n")
+	for _, c := range consts {
+		fmt.Fprintf(&buf, "\t// %s is the %s badge\n", c.ident, c.name)
+		fmt.Fprintf(&buf, "\t%s BadgeID = %q\n", c.ident, c.id)
+	}
+	buf.WriteString(")\n\n")
+	buf.WriteString("// AllBadgeIDs lists every badge ID in the requirements data\n")
+	buf.WriteString("var AllBadgeIDs = []BadgeID{\n")
+	for _, c := range consts {
+		fmt.Fprintf(&buf, "\t%s,\n", c.ident)
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+// identifier turns a badge name into a Go identifier ("Post-Up Poet" → "PostUpPoet")
+func identifier(name string) string {
+	var sb strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerate_UpToDate tests ids_gen.go matches the requirements data (run go generate if not)
+func TestGenerate_UpToDate(t *testing.T) {
+	data, err := os.ReadFile("../../data/badge_requirements.json")
+	require.NoError(t, err)
+	want, err := os.ReadFile("../../ids_gen.go")
+	require.NoError(t, err)
+
+	got, err := generate("data/badge_requirements.json", data)
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(got), "ids_gen.go is stale; run go generate ./pkg/badges")
+}
+
+// TestIdentifier tests badge names become exported Go identifiers
+func TestIdentifier(t *testing.T) {
+	assert.Equal(t, "PostUpPoet", identifier("Post-Up Poet"))
+	assert.Equal(t, "HighFlyingDenier", identifier("High-Flying Denier"))
+	assert.Equal(t, "OnBallMenace", identifier("on-ball menace"))
+}
@@ -0,0 +1,268 @@
+package badges
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// LintSeverity ranks a lint issue
+type LintSeverity int
+
+const (
+	// LintWarning is suspicious data the calculator still handles
+	LintWarning LintSeverity = iota
+	// LintError is data the calculator would score incorrectly
+	LintError
+)
+
+// severityNames are the display names indexed by LintSeverity
+var severityNames = [...]string{"warning", "error"}
+
+// String returns the string representation of a LintSeverity ("LintSeverity(2)" if out of range)
+func (s LintSeverity) String() string {
+	if s < LintWarning || s > LintError {
+		return fmt.Sprintf("LintSeverity(%d)", int(s))
+	}
+	return severityNames[s]
+}
+
+// LintIssue is one problem found in a badge requirements file
+type LintIssue struct {
+	// File is the requirements file path ("" when linting bytes)
+	File string
+	// Row is the 1-based row in the JSON array (0 for file-level issues)
+	Row int
+	// Badge is the badge ID
+	Badge string
+	// Attribute is the row's attribute ("" for badge-level issues)
+	Attribute string
+	// Severity is LintError for data the calculator would score incorrectly
+	Severity LintSeverity
+	// Message describes the problem
+	Message string
+}
+
+// String formats the issue as "file row N Badge/Attribute: severity: message"
+func (i LintIssue) String() string {
+	var parts []string
+	if i.File != "" {
+		parts = append(parts, i.File)
+	}
+	if i.Row > 0 {
+		parts = append(parts, fmt.Sprintf("row %d", i.Row))
+	}
+	if i.Badge != "" {
+		where := i.Badge
+		if i.Attribute != "" {
+			where += "/" + i.Attribute
+		}
+		parts = append(parts, where)
+	}
+	return fmt.Sprintf("%s: %s: %s", strings.Join(parts, " "), i.Severity, i.Message)
+}
+
+// Lint validates badge_requirements.json data
+// It flags tier thresholds that do not increase, attribute names the calculator
+// does not recognize (they would score 0), empty HoF/Legend cells, rows of one
+// badge that disagree on name, category, or type, and duplicate rows.
+// The error is non-nil only if the data is not a JSON array of rows.
+func Lint(data []byte) ([]LintIssue, error) {
+	var rows []rawBadgeRequirement
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, fmt.Errorf("failed to parse badge requirements: %w", err)
+	}
+
+	var issues []LintIssue
+	first := make(map[string]int)         // badge ID → index of its first row
+	seen := make(map[string]int)          // row key → index of the first row with it
+	groupTypes := make(map[string]string) // badge ID + group → group type of its first row
+	grouped := make(map[string]bool)      // badge ID → any row declares a group
+
+	for i, raw := range rows {
+		report := func(severity LintSeverity, format string, args ...any) {
+			issues = append(issues, LintIssue{
+				Row:       i + 1,
+				Badge:     raw.ID,
+				Attribute: raw.Attribute,
+				Severity:  severity,
+				Message:   fmt.Sprintf(format, args...),
+			})
+		}
+
+		if raw.ID == "" {
+			report(LintError, "row has no badge id")
+			continue
+		}
+
+		// Row-level checks
+		if !knownAttribute(raw.Attribute) {
+			report(LintError, "unknown attribute %q (scores 0 for every build)", raw.Attribute)
+		}
+		if _, err := ParseBadgeCategory(raw.Category); err != nil {
+			report(LintError, "%v", err)
+		}
+		if _, err := ParseBadgeType(raw.Type); err != nil {
+			report(LintError, "%v", err)
+		}
+		if raw.GroupType != "" {
+			if _, err := ParseBadgeType(raw.GroupType); err != nil {
+				report(LintError, "group %q: %v", raw.Group, err)
+			}
+		}
+		for _, h := range []string{raw.MinHeight, raw.MaxHeight} {
+			if h != "" && parseHeight(h) == 0 {
+				report(LintError, "unparseable height %q", h)
+			}
+		}
+		if minH, maxH := parseHeight(raw.MinHeight), parseHeight(raw.MaxHeight); minH > 0 && maxH > 0 && minH > maxH {
+			report(LintError, "min height %s is above max height %s", raw.MinHeight, raw.MaxHeight)
+		}
+		lintThresholds(raw, report)
+
+		// Badge-level checks against the badge's first row
+		if raw.Group != "" {
+			grouped[raw.ID] = true
+		}
+		if j, ok := first[raw.ID]; ok {
+			f := rows[j]
+			if raw.Badge != f.Badge {
+				report(LintError, "name %q disagrees with row %d (%q)", raw.Badge, j+1, f.Badge)
+			}
+			if raw.Category != f.Category {
+				report(LintError, "category %q disagrees with row %d (%q)", raw.Category, j+1, f.Category)
+			}
+		} else {
+			first[raw.ID] = i
+		}
+
+		if raw.Group != "" {
+			key := raw.ID + "\x00" + raw.Group
+			if t, ok := groupTypes[key]; ok && t != raw.GroupType {
+				report(LintError, "group %q type %q disagrees with earlier rows (%q)", raw.Group, raw.GroupType, t)
+			}
+			groupTypes[key] = raw.GroupType
+		}
+
+		// Duplicate rows: same attribute, group, and height band
+		key := strings.Join([]string{raw.ID, canonicalAttribute(raw.Attribute), raw.Group, raw.MinHeight, raw.MaxHeight}, "\x00")
+		if j, ok := seen[key]; ok {
+			if sameThresholds(raw, rows[j]) {
+				report(LintError, "duplicate of row %d", j+1)
+			} else {
+				report(LintError, "conflicts with row %d (same attribute and height band, different thresholds)", j+1)
+			}
+		} else {
+			seen[key] = i
+		}
+	}
+
+	// Mixed types are only intended when the badge spells out its groups
+	for id, j := range first {
+		if grouped[id] {
+			continue
+		}
+		var types []string
+		for _, raw := range rows {
+			if raw.ID == id && !slices.Contains(types, raw.Type) {
+				types = append(types, raw.Type)
+			}
+		}
+		if len(types) > 1 {
+			issues = append(issues, LintIssue{
+				Row:      j + 1,
+				Badge:    id,
+				Severity: LintWarning,
+				Message: fmt.Sprintf("rows mix types %s without groups (evaluated as every Primary row AND any Secondary row)",
+					strings.Join(types, "/")),
+			})
+		}
+	}
+
+	slices.SortStableFunc(issues, func(a, b LintIssue) int { return a.Row - b.Row })
+	return issues, nil
+}
+
+// lintThresholds checks a row's tier cells are numbers that increase tier over tier
+// with no gaps; empty trailing cells (usually HoF/Legend) are warnings
+func lintThresholds(raw rawBadgeRequirement, report func(LintSeverity, string, ...any)) {
+	cells := [...]any{nil, raw.Bronze, raw.Silver, raw.Gold, raw.HoF, raw.Legend} // indexed by BadgeTier
+
+	top, prev := BadgeTierNone, 0
+	var empty []string
+	for tier := BadgeTierBronze; tier <= BadgeTierLegendary; tier++ {
+		if s, ok := cells[tier].(string); ok && s != "" {
+			if _, err := strconv.Atoi(s); err != nil {
+				report(LintError, "%s threshold %q is not a number", tier, s)
+				continue
+			}
+		}
+
+		value := parseIntOrEmpty(cells[tier])
+		if value == 0 {
+			empty = append(empty, tier.String())
+			continue
+		}
+		if len(empty) > 0 {
+			report(LintError, "%s threshold %d follows an empty %s cell", tier, value, empty[len(empty)-1])
+			empty = nil
+		}
+		if prev > 0 && value <= prev {
+			report(LintError, "%s threshold %d does not increase on %s (%d)", tier, value, top, prev)
+		}
+		top, prev = tier, value
+	}
+
+	if len(empty) > 0 {
+		report(LintWarning, "empty %s cell(s); the requirement tops out at %s", strings.Join(empty, "/"), top)
+	}
+}
+
+// sameThresholds reports whether two rows have identical tier cells
+func sameThresholds(a, b rawBadgeRequirement) bool {
+	return a.Bronze == b.Bronze && a.Silver == b.Silver && a.Gold == b.Gold &&
+		parseIntOrEmpty(a.HoF) == parseIntOrEmpty(b.HoF) &&
+		parseIntOrEmpty(a.Legend) == parseIntOrEmpty(b.Legend)
+}
+
+// LintFiles lints each requirements file and reports any copy whose contents
+// differ from the first file
+// The sheet used to be kept in both data/ and pkg/badges/data/; it now has a
+// single canonical copy (pkg/badges/data/badge_requirements.json), so the copy
+// check only applies when callers pass several files that must match.
+func LintFiles(paths ...string) ([]LintIssue, error) {
+	var issues []LintIssue
+	var reference []byte
+
+	for i, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		fileIssues, err := Lint(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		for j := range fileIssues {
+			fileIssues[j].File = path
+		}
+		issues = append(issues, fileIssues...)
+
+		if i == 0 {
+			reference = data
+		} else if !bytes.Equal(data, reference) {
+			issues = append(issues, LintIssue{
+				File:     path,
+				Severity: LintError,
+				Message:  fmt.Sprintf("differs from %s; the copies must be identical", paths[0]),
+			})
+		}
+	}
+
+	return issues, nil
+}
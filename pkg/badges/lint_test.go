@@ -0,0 +1,102 @@
+package badges_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLint_Data tests the shipped requirements have no errors
+func TestLint_Data(t *testing.T) {
+	issues, err := badges.LintFiles("data/badge_requirements.json")
+	require.NoError(t, err)
+
+	for _, issue := range issues {
+		if issue.Severity == badges.LintError {
+			t.Errorf("%s", issue)
+		}
+	}
+}
+
+// TestLint tests each check against a hand-written sheet
+func TestLint(t *testing.T) {
+	data := `[
+	{"Category": "Defense", "Badge": "Glove", "Type": "Primary", "Attribute": "Steal", "Bronze": 60, "Silver": 70, "Gold": 80, "HoF": 90, "Legend": 95, "Min_Height": "5'9", "Max_Height": "7'4", "id": "Glove"},
+	{"Category": "Defense", "Badge": "Glove", "Type": "Primary", "Attribute": "Steal", "Bronze": 60, "Silver": 70, "Gold": 80, "HoF": 90, "Legend": 95, "Min_Height": "5'9", "Max_Height": "7'4", "id": "Glove"},
+	{"Category": "Defense", "Badge": "Glove", "Type": "Primary", "Attribute": "Steals", "Bronze": 60, "Silver": 70, "Gold": 80, "HoF": 90, "Legend": 95, "Min_Height": "5'9", "Max_Height": "7'4", "id": "Glove"},
+	{"Category": "Rebounding", "Badge": "Glove", "Type": "Secondary", "Attribute": "Block", "Bronze": 60, "Silver": 55, "Gold": 80, "HoF": "", "Legend": "", "Min_Height": "5'9", "Max_Height": "7'4", "id": "Glove"},
+	{"Category": "Defense", "Badge": "Pogo Stick", "Type": "Primary", "Attribute": "Vertical", "Bronze": 60, "Silver": 0, "Gold": 80, "HoF": 90, "Legend": 95, "Min_Height": "7'4", "Max_Height": "6'0", "id": "PogoStick"}
+]`
+
+	issues, err := badges.Lint([]byte(data))
+	require.NoError(t, err)
+
+	var messages []string
+	for _, issue := range issues {
+		messages = append(messages, issue.String())
+	}
+	all := strings.Join(messages, "\n")
+
+	assert.Contains(t, all, "row 2 Glove/Steal: error: duplicate of row 1")
+	assert.Contains(t, all, `row 3 Glove/Steals: error: unknown attribute "Steals"`)
+	assert.Contains(t, all, `row 4 Glove/Block: error: category "Rebounding" disagrees with row 1`)
+	assert.Contains(t, all, "row 4 Glove/Block: error: Silver threshold 55 does not increase on Bronze (60)")
+	assert.Contains(t, all, "row 4 Glove/Block: warning: empty Hall of Fame/Legendary cell(s)")
+	assert.Contains(t, all, "row 1 Glove: warning: rows mix types Primary/Secondary")
+	assert.Contains(t, all, "row 5 PogoStick/Vertical: error: Gold threshold 80 follows an empty Silver cell")
+	assert.Contains(t, all, "row 5 PogoStick/Vertical: error: min height 7'4 is above max height 6'0")
+	assert.Len(t, issues, 8, all)
+
+	_, err = badges.Lint([]byte(`{"not": "an array"}`))
+	assert.Error(t, err)
+}
+
+// TestLint_GroupedTypes tests mixed types are accepted when the badge declares groups
+func TestLint_GroupedTypes(t *testing.T) {
+	data := `[
+	{"Category": "Inside Scoring", "Badge": "Lift Off", "Type": "Primary", "Attribute": "Driving Dunk", "Bronze": 60, "Silver": 70, "Gold": 80, "HoF": 90, "Legend": 95, "id": "LiftOff"},
+	{"Category": "Inside Scoring", "Badge": "Lift Off", "Type": "Secondary", "Attribute": "Vertical", "Bronze": 60, "Silver": 70, "Gold": 80, "HoF": 90, "Legend": 95, "Group": "lift", "id": "LiftOff"},
+	{"Category": "Inside Scoring", "Badge": "Lift Off", "Type": "Secondary", "Attribute": "Strength", "Bronze": 60, "Silver": 70, "Gold": 80, "HoF": 90, "Legend": 95, "Group": "lift", "Group_Type": "Secondary", "id": "LiftOff"}
+]`
+
+	issues, err := badges.Lint([]byte(data))
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Contains(t, issues[0].Message, `group "lift" type "Secondary" disagrees`)
+}
+
+// TestLintFiles tests files that differ from the first are reported
+func TestLintFiles(t *testing.T) {
+	original, patched := patchedSheet(t)
+	dir := t.TempDir()
+	first := filepath.Join(dir, "first.json")
+	copied := filepath.Join(dir, "copy.json")
+	edited := filepath.Join(dir, "edited.json")
+	require.NoError(t, os.WriteFile(first, original, 0o644))
+	require.NoError(t, os.WriteFile(copied, original, 0o644))
+	require.NoError(t, os.WriteFile(edited, patched, 0o644))
+
+	issues, err := badges.LintFiles(first, copied, edited)
+	require.NoError(t, err)
+
+	var differ []string
+	for _, issue := range issues {
+		if issue.Message == "differs from "+first+"; the copies must be identical" {
+			differ = append(differ, issue.File)
+		}
+	}
+	assert.Equal(t, []string{edited}, differ)
+}
+
+// TestLintSeverityString tests severities format, including out-of-range values
+func TestLintSeverityString(t *testing.T) {
+	assert.Equal(t, "warning", badges.LintWarning.String())
+	assert.Equal(t, "error", badges.LintError.String())
+	assert.Equal(t, "LintSeverity(2)", badges.LintSeverity(2).String())
+	assert.Equal(t, "LintSeverity(-1)", badges.LintSeverity(-1).String())
+}
@@ -8,7 +8,7 @@ import (
 	"strings"
 )
 
-//go:embed data/badge_requirements.json
+//go:embed data/badge_requirements.json data/badge_descriptions.json data/badge_limits.json
 var badgeDataFS embed.FS
 
 // rawBadgeRequirement represents the JSON structure from NBA2KLab
@@ -25,6 +25,8 @@ type rawBadgeRequirement struct {
 	MinHeight string `json:"Min_Height"` // Format: "6'3"
 	MaxHeight string `json:"Max_Height"` // Format: "7'4"
 	ID        string `json:"id"`
+	Group     string `json:"Group,omitempty"`      // Optional requirement group path
+	GroupType string `json:"Group_Type,omitempty"` // Optional group combination type
 }
 
 // parseHeight converts height string like "6'3" to inches (75)
@@ -76,6 +78,11 @@ func LoadBadgeRequirements() (map[string]*BadgeRequirements, error) {
 		return nil, fmt.Errorf("failed to read badge requirements: %w", err)
 	}
 
+	return ParseBadgeRequirements(data)
+}
+
+// ParseBadgeRequirements parses badge requirements in the NBA2KLab JSON format
+func ParseBadgeRequirements(data []byte) (map[string]*BadgeRequirements, error) {
 	var rawReqs []rawBadgeRequirement
 	if err := json.Unmarshal(data, &rawReqs); err != nil {
 		return nil, fmt.Errorf("failed to parse badge requirements: %w", err)
@@ -106,6 +113,9 @@ func LoadBadgeRequirements() (map[string]*BadgeRequirements, error) {
 			Legendary:  parseIntOrEmpty(raw.Legend),
 			MinHeight:  parseHeight(raw.MinHeight),
 			MaxHeight:  parseHeight(raw.MaxHeight),
+			Type:       raw.Type,
+			Group:      raw.Group,
+			GroupType:  raw.GroupType,
 		}
 
 		badge.Requirements = append(badge.Requirements, req)
@@ -113,3 +123,18 @@ func LoadBadgeRequirements() (map[string]*BadgeRequirements, error) {
 
 	return badgeMap, nil
 }
+
+// LoadBadgeDescriptions loads badge descriptions keyed by badge ID from embedded JSON
+func LoadBadgeDescriptions() (map[string]string, error) {
+	data, err := badgeDataFS.ReadFile("data/badge_descriptions.json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read badge descriptions: %w", err)
+	}
+
+	var descriptions map[string]string
+	if err := json.Unmarshal(data, &descriptions); err != nil {
+		return nil, fmt.Errorf("failed to parse badge descriptions: %w", err)
+	}
+
+	return descriptions, nil
+}
@@ -0,0 +1,391 @@
+package badges
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// EquipLimits are the game's limits on which badges can be equipped together
+// Zero values mean unlimited.
+type EquipLimits struct {
+	// Version names the limits data (e.g., "estimated" or a patch number)
+	Version string `json:"version"`
+	// Note describes where the limits come from
+	Note string `json:"note,omitempty"`
+	// Estimated marks placeholder limits that have not been confirmed in-game;
+	// loadouts under them are estimates, not the best equippable set
+	Estimated bool `json:"estimated,omitempty"`
+	// Points is the badge point budget shared by every equipped badge
+	Points int `json:"points"`
+	// TierCosts is the points one badge costs at each tier
+	TierCosts map[BadgeTier]int `json:"tier_costs"`
+	// MaxEquipped caps the number of equipped badges
+	MaxEquipped int `json:"max_equipped"`
+	// CategorySlots caps the number of equipped badges per category
+	CategorySlots map[BadgeCategory]int `json:"category_slots"`
+	// TierSlots caps the number of badges equipped at a tier or higher
+	// ("Hall of Fame": 8 allows eight badges at Hall of Fame or Legendary)
+	TierSlots map[BadgeTier]int `json:"tier_slots"`
+}
+
+// DefaultEquipLimits returns the embedded data/badge_limits.json
+func DefaultEquipLimits() (*EquipLimits, error) {
+	data, err := badgeDataFS.ReadFile("data/badge_limits.json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read badge limits: %w", err)
+	}
+	return ParseEquipLimits(data)
+}
+
+// LoadEquipLimits reads equip limits from a JSON file
+func LoadEquipLimits(path string) (*EquipLimits, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read badge limits: %w", err)
+	}
+	limits, err := ParseEquipLimits(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return limits, nil
+}
+
+// ParseEquipLimits parses and validates equip limits JSON
+func ParseEquipLimits(data []byte) (*EquipLimits, error) {
+	var limits EquipLimits
+	if err := json.Unmarshal(data, &limits); err != nil {
+		return nil, fmt.Errorf("failed to parse badge limits: %w", err)
+	}
+
+	if limits.Points < 0 || limits.MaxEquipped < 0 {
+		return nil, fmt.Errorf("badge limits must not be negative")
+	}
+	for t := BadgeTierBronze; t <= BadgeTierLegendary; t++ {
+		if limits.TierCosts[t] < 0 || limits.TierCosts[t] < limits.TierCosts[t-1] {
+			return nil, fmt.Errorf("tier costs must not decrease (%s costs %d)", t, limits.TierCosts[t])
+		}
+	}
+	for cat, n := range limits.CategorySlots {
+		if n < 0 {
+			return nil, fmt.Errorf("%s slots must not be negative", cat)
+		}
+	}
+	for t, n := range limits.TierSlots {
+		if n < 0 || t == BadgeTierNone {
+			return nil, fmt.Errorf("invalid %s tier slots %d", t, n)
+		}
+	}
+	return &limits, nil
+}
+
+// Priority is one entry of a loadout priority list
+type Priority struct {
+	// Badge is the badge name or ID
+	Badge string
+	// MaxTier stops the allocator from spending more on the badge (None = no cap)
+	MaxTier BadgeTier
+}
+
+// Loadout is a set of badges that can be equipped together
+type Loadout struct {
+	// Equipped lists the priority badges in priority order, then the fill badges
+	Equipped []EquippedBadge
+	// Unequipped lists the available badges that did not fit, in the same order
+	Unequipped []EquippedBadge
+	// Unavailable lists the priority badges the build does not reach at all
+	Unavailable []string
+	// Points is the total cost of Equipped
+	Points int
+}
+
+// EquippedBadge is a badge's tier in a loadout
+type EquippedBadge struct {
+	Badge    string
+	Category BadgeCategory
+	// Tier is the equipped tier (None in Loadout.Unequipped) and Available the build's tier
+	Tier      BadgeTier
+	Available BadgeTier
+	// Cost is the points the equipped tier costs
+	Cost int
+	// Priority is the 1-based position in the priority list (0 for fill badges)
+	Priority int
+}
+
+// loadoutState tracks the resources a partial loadout uses
+// It is comparable so the fill search can key states by it.
+type loadoutState struct {
+	points     int
+	equipped   int
+	categories [len(categoryNames)]int
+	tiers      [BadgeTierLegendary + 1]int // badges at each tier exactly
+}
+
+// fits reports whether adding a badge at tier keeps the loadout within the limits
+func (l *EquipLimits) fits(s *loadoutState, category BadgeCategory, tier BadgeTier) bool {
+	if l.Points > 0 && s.points+l.TierCosts[tier] > l.Points {
+		return false
+	}
+	if l.MaxEquipped > 0 && s.equipped+1 > l.MaxEquipped {
+		return false
+	}
+	if n, ok := l.CategorySlots[category]; ok && n > 0 && s.categories[category]+1 > n {
+		return false
+	}
+	for slotTier, n := range l.TierSlots {
+		if n == 0 || tier < slotTier {
+			continue
+		}
+		atOrAbove := 1
+		for t := slotTier; t <= BadgeTierLegendary; t++ {
+			atOrAbove += s.tiers[t]
+		}
+		if atOrAbove > n {
+			return false
+		}
+	}
+	return true
+}
+
+// add returns the state after equipping a badge at tier
+func (l *EquipLimits) add(s loadoutState, category BadgeCategory, tier BadgeTier) loadoutState {
+	s.points += l.TierCosts[tier]
+	s.equipped++
+	if category >= 0 && int(category) < len(s.categories) {
+		s.categories[category]++
+	}
+	s.tiers[tier]++
+	return s
+}
+
+// key drops the parts of a state no limit constrains from here on, so states
+// that leave the same room for the badges still to place compare equal
+// Category counts are kept only for the categories in open, and tier counts are
+// replaced by the counts at or above each tier slot.
+func (l *EquipLimits) key(s loadoutState, open *[len(categoryNames)]bool) loadoutState {
+	if l.Points == 0 {
+		s.points = 0
+	}
+	if l.MaxEquipped == 0 {
+		s.equipped = 0
+	}
+	for c := range s.categories {
+		if !open[c] || l.CategorySlots[BadgeCategory(c)] == 0 {
+			s.categories[c] = 0
+		}
+	}
+	var atOrAbove [BadgeTierLegendary + 1]int
+	n := 0
+	for t := BadgeTierLegendary; t > BadgeTierNone; t-- {
+		n += s.tiers[t]
+		if l.TierSlots[t] > 0 {
+			atOrAbove[t] = n
+		}
+	}
+	s.tiers = atOrAbove
+	return s
+}
+
+// Allocate chooses a loadout from the build's badge tiers
+// Priorities are a strict ranking: each gets the highest tier (up to its MaxTier)
+// that still fits after the ones before it, so no loadout equips an earlier
+// priority at a higher tier. With fill, the remaining badges are then equipped
+// to maximize the total tiers (Bronze counts 1, Legendary 5) within what is left,
+// by an exact search over every badge's tier; ties go to the loadout that spends
+// fewer points.
+func (l *EquipLimits) Allocate(available []BadgeResult, priorities []Priority, fill bool) (*Loadout, error) {
+	index := make(map[string]int, len(available)) // normalized name and ID → position
+	for i, b := range available {
+		index[normalizeBadgeName(b.Name)] = i
+		index[normalizeBadgeName(b.ID)] = i
+	}
+
+	state := loadoutState{}
+	loadout := &Loadout{}
+	used := make([]bool, len(available))
+
+	equip := func(entry EquippedBadge) {
+		if entry.Tier == BadgeTierNone {
+			loadout.Unequipped = append(loadout.Unequipped, entry)
+			return
+		}
+		entry.Cost = l.TierCosts[entry.Tier]
+		state = l.add(state, entry.Category, entry.Tier)
+		loadout.Points += entry.Cost
+		loadout.Equipped = append(loadout.Equipped, entry)
+	}
+
+	for i, p := range priorities {
+		pos, ok := index[normalizeBadgeName(p.Badge)]
+		if !ok {
+			return nil, fmt.Errorf("priority %q is not among the available badges", p.Badge)
+		}
+		if used[pos] {
+			return nil, fmt.Errorf("badge %q is listed twice in the priorities", available[pos].Name)
+		}
+		used[pos] = true
+		b := available[pos]
+		if b.Tier == BadgeTierNone {
+			loadout.Unavailable = append(loadout.Unavailable, b.Name)
+			continue
+		}
+		limit := p.MaxTier
+		if limit == BadgeTierNone {
+			limit = BadgeTierLegendary
+		}
+
+		entry := EquippedBadge{Badge: b.Name, Category: b.Category, Available: b.Tier, Priority: i + 1}
+		for t := min(b.Tier, limit); t > BadgeTierNone; t-- {
+			if l.fits(&state, b.Category, t) {
+				entry.Tier = t
+				break
+			}
+		}
+		equip(entry)
+	}
+
+	if fill {
+		var rest []BadgeResult
+		for i, b := range available {
+			if !used[i] && b.Tier > BadgeTierNone {
+				rest = append(rest, b)
+			}
+		}
+		sort.SliceStable(rest, func(i, j int) bool { return rest[i].Tier > rest[j].Tier })
+		for i, tier := range l.bestFill(state, rest) {
+			b := rest[i]
+			equip(EquippedBadge{Badge: b.Name, Category: b.Category, Tier: tier, Available: b.Tier})
+		}
+	}
+
+	return loadout, nil
+}
+
+// fillNode is one partial fill in the search: the tier chosen for a badge and
+// the best fill of the badges before it
+type fillNode struct {
+	value  int // tiers summed
+	points int
+	tier   BadgeTier
+	prev   *fillNode
+}
+
+// bestFill chooses a tier (None to leave it out) for each badge, starting from
+// state, that maximizes the total tiers within the limits
+// This is a knapsack over tier choices: badges are placed a category at a time,
+// and states that leave the same room for the rest are merged, keeping the
+// better fill.
+func (l *EquipLimits) bestFill(start loadoutState, badges []BadgeResult) []BadgeTier {
+	order := make([]int, len(badges))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return badges[order[i]].Category < badges[order[j]].Category })
+
+	type frontier struct {
+		states []loadoutState
+		nodes  []*fillNode
+		index  map[loadoutState]int
+	}
+	var open [len(categoryNames)]bool
+	relax := func(f *frontier, s loadoutState, n *fillNode) {
+		k := l.key(s, &open)
+		i, seen := f.index[k]
+		if !seen {
+			f.index[k] = len(f.states)
+			f.states = append(f.states, s)
+			f.nodes = append(f.nodes, n)
+			return
+		}
+		if best := f.nodes[i]; n.value > best.value || (n.value == best.value && n.points < best.points) {
+			f.states[i], f.nodes[i] = s, n
+		}
+	}
+
+	for _, b := range badges {
+		if b.Category >= 0 && int(b.Category) < len(open) {
+			open[b.Category] = true
+		}
+	}
+	cur := &frontier{index: make(map[loadoutState]int)}
+	relax(cur, start, &fillNode{})
+	for pos, i := range order {
+		b := badges[i]
+		if last := pos == len(order)-1 || badges[order[pos+1]].Category != b.Category; last && b.Category >= 0 && int(b.Category) < len(open) {
+			open[b.Category] = false
+		}
+		next := &frontier{index: make(map[loadoutState]int, len(cur.states))}
+		for j, s := range cur.states {
+			n := cur.nodes[j]
+			for t := b.Tier; t > BadgeTierNone; t-- {
+				if l.fits(&s, b.Category, t) {
+					relax(next, l.add(s, b.Category, t), &fillNode{value: n.value + int(t), points: n.points + l.TierCosts[t], tier: t, prev: n})
+				}
+			}
+			relax(next, s, &fillNode{value: n.value, points: n.points, prev: n})
+		}
+		cur = next
+	}
+
+	best := cur.nodes[0]
+	for _, n := range cur.nodes[1:] {
+		if n.value > best.value || (n.value == best.value && n.points < best.points) {
+			best = n
+		}
+	}
+	tiers := make([]BadgeTier, len(badges))
+	for pos, n := len(order)-1, best; pos >= 0; pos, n = pos-1, n.prev {
+		tiers[order[pos]] = n.tier
+	}
+	return tiers
+}
+
+// Loadout evaluates every badge for a build and allocates a loadout under the limits
+func (c *Calculator) Loadout(attrs *scraper.AttributeCaps, limits *EquipLimits, priorities []Priority, fill bool) (*Loadout, error) {
+	for _, p := range priorities {
+		if _, exists := c.lookupID(p.Badge); !exists {
+			return nil, c.notFound(p.Badge)
+		}
+	}
+	report, err := c.Report(attrs, BadgeTierNone)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve loose spellings to names the allocator can match
+	resolved := make([]Priority, len(priorities))
+	for i, p := range priorities {
+		id, _ := c.lookupID(p.Badge)
+		resolved[i] = Priority{Badge: id, MaxTier: p.MaxTier}
+	}
+	return limits.Allocate(report.Badges, resolved, fill)
+}
+
+// String formats the entry as "Posterizer: Gold (3 pts)", noting a lower tier than available
+func (e EquippedBadge) String() string {
+	s := fmt.Sprintf("%s: %s (%d pts)", e.Badge, e.Tier, e.Cost)
+	if e.Tier < e.Available {
+		s += fmt.Sprintf(" [%s available]", e.Available)
+	}
+	return s
+}
+
+// Summary formats the loadout's usage as "52/60 points, 18 badges"
+func (lo *Loadout) Summary(limits *EquipLimits) string {
+	var parts []string
+	if limits.Points > 0 {
+		parts = append(parts, fmt.Sprintf("%d/%d points", lo.Points, limits.Points))
+	} else {
+		parts = append(parts, fmt.Sprintf("%d points", lo.Points))
+	}
+	if limits.MaxEquipped > 0 {
+		parts = append(parts, fmt.Sprintf("%d/%d badges", len(lo.Equipped), limits.MaxEquipped))
+	} else {
+		parts = append(parts, fmt.Sprintf("%d badges", len(lo.Equipped)))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,170 @@
+package badges_test
+
+import (
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var loadoutBadges = []badges.BadgeResult{
+	{ID: "Posterizer", Name: "Posterizer", Category: badges.BadgeCategoryFinishing, Tier: badges.BadgeTierLegendary},
+	{ID: "RiseUp", Name: "Rise Up", Category: badges.BadgeCategoryFinishing, Tier: badges.BadgeTierLegendary},
+	{ID: "Dimer", Name: "Dimer", Category: badges.BadgeCategoryPlaymaking, Tier: badges.BadgeTierGold},
+	{ID: "Glove", Name: "Glove", Category: badges.BadgeCategoryDefense, Tier: badges.BadgeTierSilver},
+	{ID: "Anchor", Name: "Anchor", Category: badges.BadgeCategoryDefense, Tier: badges.BadgeTierNone},
+}
+
+const loadoutLimits = `{
+	"version": "test",
+	"points": 10,
+	"tier_costs": {"Bronze": 1, "Silver": 2, "Gold": 3, "Hall of Fame": 4, "Legendary": 5},
+	"tier_slots": {"Legendary": 1}
+}`
+
+func parseLimits(t *testing.T, data string) *badges.EquipLimits {
+	t.Helper()
+	limits, err := badges.ParseEquipLimits([]byte(data))
+	require.NoError(t, err)
+	return limits
+}
+
+// TestAllocate tests priorities are settled in order under the point budget and tier slots
+func TestAllocate(t *testing.T) {
+	limits := parseLimits(t, loadoutLimits)
+
+	loadout, err := limits.Allocate(loadoutBadges, []badges.Priority{
+		{Badge: "rise up"},
+		{Badge: "Posterizer"},
+		{Badge: "Anchor"},
+		{Badge: "Dimer", MaxTier: badges.BadgeTierSilver},
+	}, true)
+	require.NoError(t, err)
+
+	// Rise Up takes the only Legendary slot, Posterizer drops to Hall of Fame, and
+	// Dimer's Silver cap no longer fits, so it drops to Bronze and spends the last point
+	assert.Equal(t, []badges.EquippedBadge{
+		{Badge: "Rise Up", Category: badges.BadgeCategoryFinishing, Tier: badges.BadgeTierLegendary, Available: badges.BadgeTierLegendary, Cost: 5, Priority: 1},
+		{Badge: "Posterizer", Category: badges.BadgeCategoryFinishing, Tier: badges.BadgeTierHallOfFame, Available: badges.BadgeTierLegendary, Cost: 4, Priority: 2},
+		{Badge: "Dimer", Category: badges.BadgeCategoryPlaymaking, Tier: badges.BadgeTierBronze, Available: badges.BadgeTierGold, Cost: 1, Priority: 4},
+	}, loadout.Equipped)
+	assert.Equal(t, []badges.EquippedBadge{
+		{Badge: "Glove", Category: badges.BadgeCategoryDefense, Available: badges.BadgeTierSilver},
+	}, loadout.Unequipped)
+	assert.Equal(t, []string{"Anchor"}, loadout.Unavailable)
+	assert.Equal(t, 10, loadout.Points)
+	assert.Equal(t, "10/10 points, 3 badges", loadout.Summary(limits))
+	assert.Equal(t, "Posterizer: Hall of Fame (4 pts) [Legendary available]", loadout.Equipped[1].String())
+}
+
+// TestAllocate_Fill tests fill badges go highest tier first under slot limits
+func TestAllocate_Fill(t *testing.T) {
+	limits := parseLimits(t, `{
+		"tier_costs": {"Bronze": 1, "Silver": 1, "Gold": 1, "Hall of Fame": 1, "Legendary": 1},
+		"max_equipped": 3,
+		"category_slots": {"Inside Scoring": 1}
+	}`)
+
+	loadout, err := limits.Allocate(loadoutBadges, nil, true)
+	require.NoError(t, err)
+
+	var equipped, unequipped []string
+	for _, e := range loadout.Equipped {
+		equipped = append(equipped, e.String())
+	}
+	for _, e := range loadout.Unequipped {
+		unequipped = append(unequipped, e.Badge)
+	}
+	assert.Equal(t, []string{"Posterizer: Legendary (1 pts)", "Dimer: Gold (1 pts)", "Glove: Silver (1 pts)"}, equipped)
+	assert.Equal(t, []string{"Rise Up"}, unequipped)
+	assert.Equal(t, "3 points, 3/3 badges", loadout.Summary(limits))
+
+	none, err := limits.Allocate(loadoutBadges, nil, false)
+	require.NoError(t, err)
+	assert.Empty(t, none.Equipped)
+}
+
+// TestAllocate_FillOptimal tests the fill finds the most tiers where taking the
+// highest tier first would not
+func TestAllocate_FillOptimal(t *testing.T) {
+	limits := parseLimits(t, `{
+		"points": 8,
+		"tier_costs": {"Bronze": 1, "Silver": 2, "Gold": 4, "Hall of Fame": 6, "Legendary": 8}
+	}`)
+
+	// Posterizer at Legendary spends all 8 points for 5 tiers; four Silvers spend
+	// the same 8 for 8
+	loadout, err := limits.Allocate(loadoutBadges, nil, true)
+	require.NoError(t, err)
+
+	var equipped, unequipped []string
+	for _, e := range loadout.Equipped {
+		equipped = append(equipped, e.String())
+	}
+	for _, e := range loadout.Unequipped {
+		unequipped = append(unequipped, e.Badge)
+	}
+	assert.Equal(t, []string{
+		"Posterizer: Silver (2 pts) [Legendary available]",
+		"Rise Up: Silver (2 pts) [Legendary available]",
+		"Dimer: Silver (2 pts) [Gold available]",
+		"Glove: Silver (2 pts)",
+	}, equipped)
+	assert.Empty(t, unequipped)
+	assert.Equal(t, 8, loadout.Points)
+}
+
+// TestAllocate_Errors tests unknown and repeated priorities are rejected
+func TestAllocate_Errors(t *testing.T) {
+	limits := parseLimits(t, loadoutLimits)
+
+	_, err := limits.Allocate(loadoutBadges, []badges.Priority{{Badge: "Deadeye"}}, false)
+	assert.Error(t, err)
+
+	_, err = limits.Allocate(loadoutBadges, []badges.Priority{{Badge: "Dimer"}, {Badge: "dimer"}}, false)
+	assert.ErrorContains(t, err, "listed twice")
+}
+
+// TestCalculatorLoadout tests priorities resolve through the calculator's badge lookup
+func TestCalculatorLoadout(t *testing.T) {
+	calc := testCalculator(t)
+	limits := parseLimits(t, loadoutLimits)
+
+	attrs := &scraper.AttributeCaps{Steal: 99, Block: 99, DrivingDunk: 80, Vertical: 80}
+	loadout, err := calc.Loadout(attrs, limits, []badges.Priority{{Badge: "anchor"}, {Badge: "Glove"}}, false)
+	require.NoError(t, err)
+	require.Len(t, loadout.Equipped, 2)
+	assert.Equal(t, "Anchor: Legendary (5 pts)", loadout.Equipped[0].String())
+	assert.Equal(t, "Glove: Hall of Fame (4 pts) [Legendary available]", loadout.Equipped[1].String())
+
+	_, err = calc.Loadout(attrs, limits, []badges.Priority{{Badge: "Glvoe"}}, false)
+	var notFound *badges.NotFoundError
+	require.ErrorAs(t, err, &notFound)
+	assert.Contains(t, notFound.Suggestions, "Glove")
+}
+
+// TestEquipLimits tests the embedded limits load and invalid limits are rejected
+func TestEquipLimits(t *testing.T) {
+	limits, err := badges.DefaultEquipLimits()
+	require.NoError(t, err)
+	assert.True(t, limits.Estimated, "the bundled limits are placeholders")
+	assert.Positive(t, limits.Points)
+	assert.Less(t, limits.TierCosts[badges.BadgeTierBronze], limits.TierCosts[badges.BadgeTierLegendary])
+
+	for name, data := range map[string]string{
+		"decreasing costs": `{"tier_costs": {"Bronze": 2, "Silver": 1}}`,
+		"negative points":  `{"points": -1}`,
+		"negative slots":   `{"category_slots": {"Defense": -1}}`,
+		"none tier slots":  `{"tier_slots": {"None": 2}}`,
+		"unknown tier":     `{"tier_costs": {"Platinum": 2}}`,
+	} {
+		_, err := badges.ParseEquipLimits([]byte(data))
+		assert.Error(t, err, name)
+	}
+
+	limits, err = badges.ParseEquipLimits([]byte(`{"version": "1.04", "points": 60}`))
+	require.NoError(t, err)
+	assert.False(t, limits.Estimated)
+}
@@ -0,0 +1,88 @@
+package badges_test
+
+import (
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBadgeMetadata tests typed metadata lookup by name and ID
+func TestBadgeMetadata(t *testing.T) {
+	calc, err := badges.NewCalculator()
+	require.NoError(t, err)
+
+	b, err := calc.Badge("Versatile Visionary")
+	require.NoError(t, err)
+	assert.Equal(t, "VersatileVisionary", b.ID)
+	assert.Equal(t, badges.BadgeCategoryPlaymaking, b.Category)
+	assert.Equal(t, badges.BadgeTypePrimary, b.Type)
+	assert.NotEmpty(t, b.Description)
+	assert.NotEmpty(t, b.Requirements)
+
+	b, err = calc.Badge("Off-BallPest")
+	require.NoError(t, err)
+	assert.Equal(t, "Off-Ball Pest", b.Name)
+	assert.Equal(t, badges.BadgeCategoryDefense, b.Category)
+	assert.Equal(t, badges.BadgeTypeSecondary, b.Type)
+
+	_, err = calc.Badge("NonexistentBadge")
+	assert.Error(t, err)
+}
+
+// TestBadges tests that every badge has metadata and comes back in a stable order
+func TestBadges(t *testing.T) {
+	calc, err := badges.NewCalculator()
+	require.NoError(t, err)
+
+	all := calc.Badges()
+	assert.Len(t, all, len(calc.ListAllBadges()))
+
+	for i, b := range all {
+		assert.NotEmpty(t, b.Description, "badge %s has no description", b.Name)
+		assert.NotEmpty(t, b.Requirements, "badge %s has no requirements", b.Name)
+		assert.NotEmpty(t, b.Category.SourceName(), "badge %s", b.Name)
+
+		if i > 0 {
+			prev := all[i-1]
+			ordered := prev.Category < b.Category || (prev.Category == b.Category && prev.Name < b.Name)
+			assert.True(t, ordered, "%s should sort before %s", prev.Name, b.Name)
+		}
+	}
+}
+
+// TestParseBadgeCategory tests the NBA2KLab category strings round-trip
+func TestParseBadgeCategory(t *testing.T) {
+	for cat := badges.BadgeCategoryFinishing; cat <= badges.BadgeCategoryAllAround; cat++ {
+		parsed, err := badges.ParseBadgeCategory(cat.SourceName())
+		require.NoError(t, err)
+		assert.Equal(t, cat, parsed)
+	}
+
+	_, err := badges.ParseBadgeCategory("Finishing")
+	assert.Error(t, err, "enum names are not NBA2KLab category strings")
+}
+
+// TestParseBadgeType tests BadgeType parsing and string representation
+func TestParseBadgeType(t *testing.T) {
+	for _, bt := range []badges.BadgeType{badges.BadgeTypePrimary, badges.BadgeTypeSecondary} {
+		parsed, err := badges.ParseBadgeType(bt.String())
+		require.NoError(t, err)
+		assert.Equal(t, bt, parsed)
+	}
+
+	_, err := badges.ParseBadgeType("Tertiary")
+	assert.Error(t, err)
+}
+
+// TestBadgeCategoryType_OutOfRange tests String does not panic on invalid categories and types
+func TestBadgeCategoryType_OutOfRange(t *testing.T) {
+	assert.Equal(t, "All-Around", badges.BadgeCategoryAllAround.String())
+	assert.Equal(t, "BadgeCategory(7)", badges.BadgeCategory(7).String())
+	assert.Equal(t, "BadgeCategory(-1)", badges.BadgeCategory(-1).String())
+
+	assert.Equal(t, "Secondary", badges.BadgeTypeSecondary.String())
+	assert.Equal(t, "BadgeType(2)", badges.BadgeType(2).String())
+	assert.Equal(t, "BadgeType(-1)", badges.BadgeType(-1).String())
+}
@@ -0,0 +1,126 @@
+package badges
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// NearMiss is a badge a build falls just short of at its next tier
+type NearMiss struct {
+	// Badge is the badge name
+	Badge    string
+	Category BadgeCategory
+	// Tier is the build's tier today (possibly None) and Next the tier it misses
+	Tier BadgeTier
+	Next BadgeTier
+	// Points is the total attribute points Gaps need
+	Points int
+	// Gaps are the attributes that need to move, most points first
+	Gaps []AttributeGap
+}
+
+// AttributeGap is how far one attribute is below a threshold
+type AttributeGap struct {
+	Attribute string
+	Value     int
+	Needed    int
+}
+
+// Points returns how many points the attribute is short
+func (g AttributeGap) Points() int {
+	return g.Needed - g.Value
+}
+
+// String formats the gap as "+3 Driving Dunk (85 → 88)"
+func (g AttributeGap) String() string {
+	return fmt.Sprintf("+%d %s (%d → %d)", g.Points(), g.Attribute, g.Value, g.Needed)
+}
+
+// String formats the near miss as "Aerial Wizard Gold → Hall of Fame: +3 Driving Dunk (85 → 88)"
+func (m NearMiss) String() string {
+	gaps := make([]string, len(m.Gaps))
+	for i, g := range m.Gaps {
+		gaps[i] = g.String()
+	}
+	return fmt.Sprintf("%s %s → %s: %s", m.Badge, m.Tier, m.Next, strings.Join(gaps, ", "))
+}
+
+// NearMisses returns the badges whose next tier is within the given number of
+// attribute points, including badges the build does not have yet
+// When a badge's tiers can be met several ways (Secondary requirements), the
+// cheapest way is reported. Code-defined badges and tiers the build's height
+// cannot reach are skipped. Results are ordered by points, then category, then name.
+func (c *Calculator) NearMisses(attrs *scraper.AttributeCaps, within int) ([]NearMiss, error) {
+	var misses []NearMiss
+	for _, b := range c.badges {
+		if b.Calc != nil {
+			continue
+		}
+		tier, err := c.GetBadgeTier(b.ID, attrs)
+		if err != nil {
+			return nil, err
+		}
+		if tier >= BadgeTierLegendary {
+			continue
+		}
+
+		best, bestCost := cheapestPath(c.alternatives(b.Tree, tier+1, attrs.Height), attrs)
+		if bestCost <= 0 || bestCost > within {
+			continue
+		}
+
+		misses = append(misses, NearMiss{
+			Badge:    b.Name,
+			Category: b.Category,
+			Tier:     tier,
+			Next:     tier + 1,
+			Points:   bestCost,
+			Gaps:     pathGaps(best, attrs),
+		})
+	}
+
+	sort.Slice(misses, func(i, j int) bool {
+		a, b := misses[i], misses[j]
+		if a.Points != b.Points {
+			return a.Points < b.Points
+		}
+		if a.Category != b.Category {
+			return a.Category < b.Category
+		}
+		return a.Badge < b.Badge
+	})
+	return misses, nil
+}
+
+// cheapestPath returns the way to meet a tier that needs the fewest points from
+// the current ratings, and its cost (-1 if there is no way)
+func cheapestPath(paths []requirementSet, attrs *scraper.AttributeCaps) (requirementSet, int) {
+	var best requirementSet
+	bestCost := -1
+	for _, path := range paths {
+		if cost := path.cost(attrs); bestCost < 0 || cost < bestCost {
+			best, bestCost = path, cost
+		}
+	}
+	return best, bestCost
+}
+
+// pathGaps lists the attributes below their minimum in path, most points first
+func pathGaps(path requirementSet, attrs *scraper.AttributeCaps) []AttributeGap {
+	var gaps []AttributeGap
+	for name, needed := range path {
+		if value, _ := attrs.Get(name); value < needed {
+			gaps = append(gaps, AttributeGap{Attribute: name, Value: value, Needed: needed})
+		}
+	}
+	sort.Slice(gaps, func(i, j int) bool {
+		if gaps[i].Points() != gaps[j].Points() {
+			return gaps[i].Points() > gaps[j].Points()
+		}
+		return gaps[i].Attribute < gaps[j].Attribute
+	})
+	return gaps
+}
@@ -0,0 +1,52 @@
+package badges_test
+
+import (
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNearMisses tests badges within the point budget of their next tier are listed
+func TestNearMisses(t *testing.T) {
+	calc := testCalculator(t)
+
+	attrs := &scraper.AttributeCaps{
+		Steal:            57, // None, 3 short of Bronze
+		DrivingDunk:      78, // Silver, Gold needs +2 Driving Dunk and +1 Vertical
+		Vertical:         79, // also Rim Guard Gold, HoF needs +1 Vertical
+		OffensiveRebound: 86, // Gold, HoF cheapest via Offensive Rebound (+4)
+		DefensiveRebound: 70,
+		Block:            99, // Legendary, nothing left
+	}
+
+	misses, err := calc.NearMisses(attrs, 4)
+	require.NoError(t, err)
+	require.Len(t, misses, 4)
+
+	assert.Equal(t, "Rim Guard Gold → Hall of Fame: +1 Vertical (79 → 80)", misses[0].String())
+
+	// Ties on points go by category: Finishing before Defense
+	assert.Equal(t, "Posterizer", misses[1].Badge)
+	assert.Equal(t, 3, misses[1].Points)
+	assert.Equal(t, "Posterizer Silver → Gold: +2 Driving Dunk (78 → 80), +1 Vertical (79 → 80)", misses[1].String())
+
+	assert.Equal(t, badges.NearMiss{
+		Badge:    "Glove",
+		Category: badges.BadgeCategoryDefense,
+		Tier:     badges.BadgeTierNone,
+		Next:     badges.BadgeTierBronze,
+		Points:   3,
+		Gaps:     []badges.AttributeGap{{Attribute: "Steal", Value: 57, Needed: 60}},
+	}, misses[2])
+
+	assert.Equal(t, "Rebound Chaser", misses[3].Badge)
+	assert.Equal(t, []badges.AttributeGap{{Attribute: "Offensive Rebound", Value: 86, Needed: 90}}, misses[3].Gaps)
+
+	fewer, err := calc.NearMisses(attrs, 2)
+	require.NoError(t, err)
+	require.Len(t, fewer, 1)
+	assert.Equal(t, "Rim Guard", fewer[0].Badge)
+}
@@ -0,0 +1,97 @@
+package badges
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// registry holds the code-defined badges added with Register, keyed by badge ID
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]Badge)
+)
+
+// Register adds a code-defined badge to every calculator created afterwards
+// Code-defined badges compute their tier with Calc instead of JSON requirement rows,
+// for rules the NBA2KLab schema cannot express. A registered badge replaces a
+// JSON-defined badge with the same ID. Register is meant to be called from init
+// and panics if the badge is incomplete or its ID is already registered.
+func Register(b Badge) {
+	if err := validateCodeBadge(b); err != nil {
+		panic(err)
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, dup := registry[b.ID]; dup {
+		panic(fmt.Sprintf("badges: Register called twice for badge %q", b.ID))
+	}
+	registry[b.ID] = b
+}
+
+// WithBadges adds code-defined badges to one calculator
+// They replace JSON-defined and registered badges with the same ID.
+func WithBadges(badges ...Badge) Option {
+	return func(cfg *calculatorConfig) {
+		cfg.badges = append(cfg.badges, badges...)
+	}
+}
+
+// registeredBadges returns the registered badges ordered by ID
+func registeredBadges() []Badge {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	result := make([]Badge, 0, len(registry))
+	for _, b := range registry {
+		result = append(result, b)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+// validateCodeBadge checks a code-defined badge has an ID without spaces, a name, and a Calc
+func validateCodeBadge(b Badge) error {
+	switch {
+	case b.ID == "" || strings.Contains(b.ID, " "):
+		return fmt.Errorf("badges: code-defined badge %q needs an ID without spaces", b.Name)
+	case b.Name == "":
+		return fmt.Errorf("badges: code-defined badge %q needs a name", b.ID)
+	case b.Calc == nil:
+		return fmt.Errorf("badges: code-defined badge %q needs a Calc function", b.ID)
+	}
+	return nil
+}
+
+// addCodeBadges merges code-defined badges over the JSON-defined ones
+// A badge without a description keeps the JSON badge's or the embedded one.
+func (c *Calculator) addCodeBadges(code []Badge, descriptions map[string]string) error {
+	for _, b := range code {
+		if err := validateCodeBadge(b); err != nil {
+			return err
+		}
+		if b.Description == "" {
+			if prev, exists := c.badges[b.ID]; exists {
+				b.Description = prev.Description
+			} else {
+				b.Description = descriptions[b.ID]
+			}
+		}
+		c.badges[b.ID] = &b
+	}
+	return nil
+}
+
+// codeBadgeIDs lists the IDs of the calculator's code-defined badges, sorted
+func (c *Calculator) codeBadgeIDs() []string {
+	var ids []string
+	for id, b := range c.badges {
+		if b.Calc != nil {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
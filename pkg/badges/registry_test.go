@@ -0,0 +1,108 @@
+package badges_test
+
+import (
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tallPosterizer is Posterizer redefined in Go: Gold for anyone 7'0" or taller
+var tallPosterizer = badges.Badge{
+	ID:       "Posterizer",
+	Name:     "Posterizer",
+	Category: badges.BadgeCategoryFinishing,
+	Calc: func(attrs *scraper.AttributeCaps) badges.BadgeTier {
+		if attrs.Height >= 84 {
+			return badges.BadgeTierGold
+		}
+		return badges.BadgeTierNone
+	},
+}
+
+// TestWithBadges_Override tests a code-defined badge replaces the JSON-defined one
+func TestWithBadges_Override(t *testing.T) {
+	embedded, err := badges.NewCalculator()
+	require.NoError(t, err)
+	calc, err := badges.NewCalculator(badges.WithBadges(tallPosterizer))
+	require.NoError(t, err)
+
+	assert.Len(t, calc.Badges(), len(embedded.Badges()))
+	assert.NotEqual(t, embedded.DataVersion(), calc.DataVersion())
+
+	b, err := calc.Badge("posterizer")
+	require.NoError(t, err)
+	assert.NotNil(t, b.Calc)
+	assert.Nil(t, b.Tree)
+	assert.NotEmpty(t, b.Description, "keeps the JSON badge's description")
+
+	// The JSON rules would need Driving Dunk and Vertical
+	tall := &scraper.AttributeCaps{Height: 85}
+	tier, err := calc.GetBadgeTier("Posterizer", tall)
+	require.NoError(t, err)
+	assert.Equal(t, badges.BadgeTierGold, tier)
+	assert.Equal(t, badges.BadgeTierGold, calc.GetAvailableBadges(tall)["Posterizer"])
+
+	tier, err = calc.GetBadgeTier("Posterizer", &scraper.AttributeCaps{Height: 80, DrivingDunk: 99, Vertical: 99})
+	require.NoError(t, err)
+	assert.Equal(t, badges.BadgeTierNone, tier)
+
+	// The override is per calculator
+	tier, err = embedded.GetBadgeTier("Posterizer", tall)
+	require.NoError(t, err)
+	assert.Equal(t, badges.BadgeTierNone, tier)
+
+	_, err = calc.PlanUpgrades(tall, tall, map[string]badges.BadgeTier{"Posterizer": badges.BadgeTierGold})
+	assert.ErrorContains(t, err, "code-defined")
+}
+
+// TestWithBadges_New tests a code-defined badge alongside the JSON-defined ones
+func TestWithBadges_New(t *testing.T) {
+	embedded, err := badges.NewCalculator()
+	require.NoError(t, err)
+
+	calc, err := badges.NewCalculator(badges.WithBadges(badges.Badge{
+		ID:          "TwoWayAnchor",
+		Name:        "Two-Way Anchor",
+		Category:    badges.BadgeCategoryDefense,
+		Description: "Rewards elite rim protection paired with post scoring",
+		Calc: func(attrs *scraper.AttributeCaps) badges.BadgeTier {
+			return min(badges.BadgeTier((attrs.Block-70)/5), badges.BadgeTier((attrs.PostControl-70)/5), badges.BadgeTierLegendary)
+		},
+	}))
+	require.NoError(t, err)
+	assert.Len(t, calc.Badges(), len(embedded.Badges())+1)
+
+	b, err := calc.Badge("two-way anchor")
+	require.NoError(t, err)
+	assert.Equal(t, "TwoWayAnchor", b.ID)
+
+	attrs := &scraper.AttributeCaps{Block: 92, PostControl: 90}
+	eval, err := calc.EvaluateBadge("Two-Way Anchor", attrs)
+	require.NoError(t, err)
+	assert.Equal(t, badges.BadgeTierHallOfFame, eval.Tier)
+
+	category := calc.GetBadgesByCategory(badges.BadgeCategoryDefense, attrs)
+	assert.Equal(t, badges.BadgeTierHallOfFame, category["Two-Way Anchor"])
+}
+
+// TestWithBadges_Invalid tests incomplete code-defined badges are rejected
+func TestWithBadges_Invalid(t *testing.T) {
+	noCalc := tallPosterizer
+	noCalc.Calc = nil
+	_, err := badges.NewCalculator(badges.WithBadges(noCalc))
+	assert.ErrorContains(t, err, "Calc")
+
+	spacedID := tallPosterizer
+	spacedID.ID = "Tall Posterizer"
+	_, err = badges.NewCalculator(badges.WithBadges(spacedID))
+	assert.ErrorContains(t, err, "ID")
+}
+
+// TestRegister_Invalid tests Register panics on incomplete badges
+func TestRegister_Invalid(t *testing.T) {
+	assert.Panics(t, func() { badges.Register(badges.Badge{ID: "Nameless", Calc: tallPosterizer.Calc}) })
+	assert.Panics(t, func() { badges.Register(badges.Badge{ID: "NoCalc", Name: "No Calc"}) })
+}
@@ -0,0 +1,170 @@
+package badges
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"gopkg.in/yaml.v3"
+)
+
+// BadgeReport is every badge's tier for a build in a stable order
+type BadgeReport struct {
+	// Height, Wingspan, and Weight identify the build
+	Height   int `json:"height" yaml:"height"`
+	Wingspan int `json:"wingspan" yaml:"wingspan"`
+	Weight   int `json:"weight" yaml:"weight"`
+	// DataVersion is the requirements data the report was computed from
+	DataVersion string `json:"data_version" yaml:"data_version"`
+	// Badges are ordered by category, then tier (highest first), then name
+	Badges []BadgeResult `json:"badges" yaml:"badges"`
+	// Summary counts the reported badges
+	Summary ReportSummary `json:"summary" yaml:"summary"`
+}
+
+// BadgeResult is one badge's tier in a report
+type BadgeResult struct {
+	ID       string        `json:"id" yaml:"id"`
+	Name     string        `json:"name" yaml:"name"`
+	Category BadgeCategory `json:"category" yaml:"category"`
+	Tier     BadgeTier     `json:"tier" yaml:"tier"`
+}
+
+// ReportSummary counts a report's badges per tier and category
+type ReportSummary struct {
+	// Total is the number of reported badges
+	Total int `json:"total" yaml:"total"`
+	// ByTier and ByCategory hold only non-zero counts
+	ByTier     map[BadgeTier]int     `json:"by_tier" yaml:"by_tier"`
+	ByCategory map[BadgeCategory]int `json:"by_category" yaml:"by_category"`
+}
+
+// Report evaluates every badge for a build and returns those at or above minTier
+// Use BadgeTierNone to include unavailable badges.
+func (c *Calculator) Report(attrs *scraper.AttributeCaps, minTier BadgeTier) (*BadgeReport, error) {
+	report := &BadgeReport{
+		Height:      attrs.Height,
+		Wingspan:    attrs.Wingspan,
+		Weight:      attrs.Weight,
+		DataVersion: c.version,
+		Badges:      []BadgeResult{},
+		Summary: ReportSummary{
+			ByTier:     make(map[BadgeTier]int),
+			ByCategory: make(map[BadgeCategory]int),
+		},
+	}
+
+	for _, b := range c.badges {
+		tier, err := c.GetBadgeTier(b.ID, attrs)
+		if err != nil {
+			return nil, err
+		}
+		if tier < minTier {
+			continue
+		}
+		result := BadgeResult{ID: b.ID, Name: b.Name, Category: b.Category, Tier: tier}
+		report.Badges = append(report.Badges, result)
+		report.Summary.add(result)
+	}
+
+	sort.Slice(report.Badges, func(i, j int) bool {
+		a, b := report.Badges[i], report.Badges[j]
+		if a.Category != b.Category {
+			return a.Category < b.Category
+		}
+		if a.Tier != b.Tier {
+			return a.Tier > b.Tier
+		}
+		return a.Name < b.Name
+	})
+
+	return report, nil
+}
+
+// Filter returns a copy of the report with only the badges keep accepts, recounted
+func (r *BadgeReport) Filter(keep func(BadgeResult) bool) *BadgeReport {
+	filtered := *r
+	filtered.Badges = []BadgeResult{}
+	filtered.Summary = ReportSummary{
+		ByTier:     make(map[BadgeTier]int),
+		ByCategory: make(map[BadgeCategory]int),
+	}
+	for _, b := range r.Badges {
+		if keep(b) {
+			filtered.Badges = append(filtered.Badges, b)
+			filtered.Summary.add(b)
+		}
+	}
+	return &filtered
+}
+
+// add counts one badge in the summary
+func (s *ReportSummary) add(b BadgeResult) {
+	s.Total++
+	s.ByTier[b.Tier]++
+	s.ByCategory[b.Category]++
+}
+
+// Report formats accepted by Encode
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+// Encode writes the report as text, JSON, or YAML
+func (r *BadgeReport) Encode(w io.Writer, format string) error {
+	switch strings.ToLower(format) {
+	case FormatText, "":
+		_, err := io.WriteString(w, r.String())
+		return err
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r)
+	case FormatYAML, "yml":
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+		return enc.Close()
+	default:
+		return fmt.Errorf("unknown report format %q (use text, json, or yaml)", format)
+	}
+}
+
+// String renders the report as text grouped by category, with a summary line
+func (r *BadgeReport) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Build: %d\" / %d\" / %d lbs (requirements %s)\n", r.Height, r.Wingspan, r.Weight, r.DataVersion)
+
+	for i, b := range r.Badges {
+		if i == 0 || b.Category != r.Badges[i-1].Category {
+			fmt.Fprintf(&sb, "\n%s (%d):\n", b.Category, r.Summary.ByCategory[b.Category])
+		}
+		fmt.Fprintf(&sb, "  %-13s %s\n", b.Tier, b.Name)
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(r.Summary.String())
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// String formats the counts as "23 badges: 3 Legendary, 5 Hall of Fame, ..." from the highest tier down
+func (s ReportSummary) String() string {
+	var parts []string
+	for t := BadgeTierLegendary; t >= BadgeTierNone; t-- {
+		if n := s.ByTier[t]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, t))
+		}
+	}
+	if len(parts) == 0 {
+		return fmt.Sprintf("%d badges", s.Total)
+	}
+	return fmt.Sprintf("%d badges: %s", s.Total, strings.Join(parts, ", "))
+}
@@ -0,0 +1,145 @@
+package badges_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+var reportAttrs = &scraper.AttributeCaps{Height: 84, Wingspan: 87, Weight: 260, Steal: 82, Block: 91, PerimeterDefense: 40, DrivingDunk: 75, Vertical: 75}
+
+// TestBadgeTier_OutOfRange tests String does not panic on invalid tiers
+func TestBadgeTier_OutOfRange(t *testing.T) {
+	assert.Equal(t, "BadgeTier(7)", badges.BadgeTier(7).String())
+	assert.Equal(t, "BadgeTier(-1)", badges.BadgeTier(-1).String())
+
+	_, err := json.Marshal(badges.BadgeTier(7))
+	assert.Error(t, err)
+}
+
+// TestParseBadgeTier tests tier names in any casing and common abbreviations
+func TestParseBadgeTier(t *testing.T) {
+	tests := []struct {
+		input string
+		want  badges.BadgeTier
+	}{
+		{"None", badges.BadgeTierNone},
+		{"bronze", badges.BadgeTierBronze},
+		{"SILVER", badges.BadgeTierSilver},
+		{"Gold", badges.BadgeTierGold},
+		{"HoF", badges.BadgeTierHallOfFame},
+		{"Hall of Fame", badges.BadgeTierHallOfFame},
+		{"legend", badges.BadgeTierLegendary},
+		{"Legendary", badges.BadgeTierLegendary},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			tier, err := badges.ParseBadgeTier(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, tier)
+		})
+	}
+
+	_, err := badges.ParseBadgeTier("Platinum")
+	assert.Error(t, err)
+}
+
+// TestBadgeTier_JSON tests tiers marshal as names, including as map keys
+func TestBadgeTier_JSON(t *testing.T) {
+	data, err := json.Marshal(map[badges.BadgeTier]int{badges.BadgeTierHallOfFame: 2})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"Hall of Fame": 2}`, string(data))
+
+	var tiers []badges.BadgeTier
+	require.NoError(t, json.Unmarshal([]byte(`["Gold", "hof", "None"]`), &tiers))
+	assert.Equal(t, []badges.BadgeTier{badges.BadgeTierGold, badges.BadgeTierHallOfFame, badges.BadgeTierNone}, tiers)
+
+	var tier badges.BadgeTier
+	assert.Error(t, json.Unmarshal([]byte(`"Platinum"`), &tier))
+}
+
+// TestReport tests badges are ordered by category, tier, then name and counted
+func TestReport(t *testing.T) {
+	calc := testCalculator(t)
+
+	report, err := calc.Report(reportAttrs, badges.BadgeTierNone)
+	require.NoError(t, err)
+
+	var names []string
+	for _, b := range report.Badges {
+		names = append(names, b.Name)
+	}
+	assert.Equal(t, []string{"Posterizer", "Dimer", "Anchor", "Disruptor", "Glove", "Rim Guard", "Interceptor", "Rebound Chaser"}, names)
+	assert.Equal(t, badges.BadgeTierHallOfFame, report.Badges[2].Tier)
+
+	assert.Equal(t, 8, report.Summary.Total)
+	assert.Equal(t, map[badges.BadgeTier]int{
+		badges.BadgeTierNone:       3,
+		badges.BadgeTierSilver:     1,
+		badges.BadgeTierGold:       3,
+		badges.BadgeTierHallOfFame: 1,
+	}, report.Summary.ByTier)
+	assert.Equal(t, map[badges.BadgeCategory]int{
+		badges.BadgeCategoryFinishing:  1,
+		badges.BadgeCategoryPlaymaking: 1,
+		badges.BadgeCategoryDefense:    5,
+		badges.BadgeCategoryRebounding: 1,
+	}, report.Summary.ByCategory)
+	assert.Equal(t, "8 badges: 1 Hall of Fame, 3 Gold, 1 Silver, 3 None", report.Summary.String())
+
+	available, err := calc.Report(reportAttrs, badges.BadgeTierGold)
+	require.NoError(t, err)
+	assert.Equal(t, 4, available.Summary.Total)
+
+	defense := report.Filter(func(b badges.BadgeResult) bool { return b.Category == badges.BadgeCategoryDefense })
+	assert.Len(t, defense.Badges, 5)
+	assert.Equal(t, 5, defense.Summary.Total)
+	assert.Equal(t, 8, report.Summary.Total, "Filter leaves the original report alone")
+}
+
+// TestReport_Encode tests the report round-trips through JSON and YAML and renders as text
+func TestReport_Encode(t *testing.T) {
+	calc := testCalculator(t)
+	report, err := calc.Report(reportAttrs, badges.BadgeTierBronze)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, report.Encode(&buf, badges.FormatJSON))
+	assert.Contains(t, buf.String(), `"tier": "Hall of Fame"`)
+	assert.Contains(t, buf.String(), `"category": "Defense"`)
+	var fromJSON badges.BadgeReport
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &fromJSON))
+	assert.Equal(t, *report, fromJSON)
+
+	buf.Reset()
+	require.NoError(t, report.Encode(&buf, badges.FormatYAML))
+	assert.Contains(t, buf.String(), "tier: Hall of Fame")
+	var fromYAML badges.BadgeReport
+	require.NoError(t, yaml.Unmarshal(buf.Bytes(), &fromYAML))
+	assert.Equal(t, *report, fromYAML)
+
+	buf.Reset()
+	require.NoError(t, report.Encode(&buf, badges.FormatText))
+	assert.Contains(t, buf.String(), "Defense (4):\n  Hall of Fame  Anchor\n  Gold          Disruptor\n  Gold          Glove\n  Gold          Rim Guard\n")
+	assert.Contains(t, buf.String(), "5 badges: 1 Hall of Fame, 3 Gold, 1 Silver")
+
+	assert.Error(t, report.Encode(&buf, "xml"))
+}
+
+// TestListAllBadges_Sorted tests badge names come back in a stable order
+func TestListAllBadges_Sorted(t *testing.T) {
+	calc, err := badges.NewCalculator()
+	require.NoError(t, err)
+
+	names := calc.ListAllBadges()
+	assert.True(t, sort.StringsAreSorted(names))
+	assert.Equal(t, names, calc.ListAllBadges())
+}
@@ -0,0 +1,119 @@
+package badges
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Option configures NewCalculator
+type Option func(*calculatorConfig)
+
+// calculatorConfig holds where NewCalculator reads badge requirements from
+type calculatorConfig struct {
+	source string                 // label reported by Calculator.Source
+	read   func() ([]byte, error) // nil for the embedded copy
+	badges []Badge                // code-defined badges added with WithBadges
+}
+
+// WithRequirementsFile loads badge requirements from a JSON file instead of the embedded copy
+func WithRequirementsFile(path string) Option {
+	return func(cfg *calculatorConfig) {
+		cfg.source = path
+		cfg.read = func() ([]byte, error) { return os.ReadFile(path) }
+	}
+}
+
+// WithRequirementsReader loads badge requirements from a reader instead of the embedded copy
+func WithRequirementsReader(r io.Reader) Option {
+	return func(cfg *calculatorConfig) {
+		cfg.source = "reader"
+		cfg.read = func() ([]byte, error) { return io.ReadAll(r) }
+	}
+}
+
+// WithRequirementsDir loads one patch version from a directory of requirement files
+// named <version>.json (e.g., "1.04.json"). An empty version picks the latest.
+func WithRequirementsDir(dir, version string) Option {
+	return func(cfg *calculatorConfig) {
+		cfg.source = dir
+		cfg.read = func() ([]byte, error) {
+			if version == "" {
+				versions, err := ListRequirementVersions(dir)
+				if err != nil {
+					return nil, err
+				}
+				if len(versions) == 0 {
+					return nil, fmt.Errorf("no requirement versions in %s", dir)
+				}
+				version = versions[len(versions)-1]
+			}
+			cfg.source = filepath.Join(dir, version+".json")
+			return os.ReadFile(cfg.source)
+		}
+	}
+}
+
+// ListRequirementVersions lists the patch versions in a requirements directory, oldest first
+// Versions are file names without ".json", ordered with numbers compared numerically
+// so "1.10" sorts after "1.9".
+func ListRequirementVersions(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read requirements directory: %w", err)
+	}
+
+	var versions []string
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		versions = append(versions, strings.TrimSuffix(e.Name(), ".json"))
+	}
+
+	sort.Slice(versions, func(i, j int) bool { return versionLess(versions[i], versions[j]) })
+	return versions, nil
+}
+
+// versionLess compares versions chunk by chunk, numeric chunks by value
+func versionLess(a, b string) bool {
+	ca, cb := versionChunks(a), versionChunks(b)
+	for i := 0; i < len(ca) && i < len(cb); i++ {
+		if ca[i] == cb[i] {
+			continue
+		}
+		na, errA := strconv.Atoi(ca[i])
+		nb, errB := strconv.Atoi(cb[i])
+		if errA == nil && errB == nil {
+			return na < nb
+		}
+		return ca[i] < cb[i]
+	}
+	return len(ca) < len(cb)
+}
+
+// versionChunks splits a version into runs of digits and non-digits ("1.10b" → "1", ".", "10", "b")
+func versionChunks(s string) []string {
+	var chunks []string
+	start := 0
+	for i := 1; i <= len(s); i++ {
+		if i == len(s) || unicode.IsDigit(rune(s[i])) != unicode.IsDigit(rune(s[i-1])) {
+			chunks = append(chunks, s[start:i])
+			start = i
+		}
+	}
+	return chunks
+}
+
+// dataVersion returns a short content hash identifying a requirements file
+func dataVersion(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:12]
+}
@@ -0,0 +1,83 @@
+package badges_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// patchedSheet returns the shipped requirements with Aerial Wizard's Driving Dunk Bronze lowered to 10
+func patchedSheet(t *testing.T) (original, patched []byte) {
+	t.Helper()
+	original, err := os.ReadFile("data/badge_requirements.json")
+	require.NoError(t, err)
+	patched = []byte(strings.Replace(string(original), `"Bronze": 64,`, `"Bronze": 10,`, 1))
+	require.NotEqual(t, original, patched)
+	return original, patched
+}
+
+// TestNewCalculator_Sources tests file, reader, and embedded sources load the same sheet
+func TestNewCalculator_Sources(t *testing.T) {
+	original, _ := patchedSheet(t)
+	path := filepath.Join(t.TempDir(), "requirements.json")
+	require.NoError(t, os.WriteFile(path, original, 0o644))
+
+	embedded, err := badges.NewCalculator()
+	require.NoError(t, err)
+	assert.Equal(t, "embedded", embedded.Source())
+	assert.Len(t, embedded.DataVersion(), 12)
+
+	fromFile, err := badges.NewCalculator(badges.WithRequirementsFile(path))
+	require.NoError(t, err)
+	assert.Equal(t, path, fromFile.Source())
+	assert.Equal(t, embedded.DataVersion(), fromFile.DataVersion())
+
+	fromReader, err := badges.NewCalculator(badges.WithRequirementsReader(strings.NewReader(string(original))))
+	require.NoError(t, err)
+	assert.Equal(t, embedded.DataVersion(), fromReader.DataVersion())
+	assert.ElementsMatch(t, embedded.ListAllBadges(), fromReader.ListAllBadges())
+
+	_, err = badges.NewCalculator(badges.WithRequirementsFile(filepath.Join(t.TempDir(), "missing.json")))
+	assert.Error(t, err)
+
+	_, err = badges.NewCalculator(badges.WithRequirementsReader(strings.NewReader("not json")))
+	assert.Error(t, err)
+}
+
+// TestNewCalculator_Dir tests patch versions are ordered numerically and the latest is the default
+func TestNewCalculator_Dir(t *testing.T) {
+	original, patched := patchedSheet(t)
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "1.9.json"), original, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "1.10.json"), patched, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0o644))
+
+	versions, err := badges.ListRequirementVersions(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.9", "1.10"}, versions)
+
+	attrs := &scraper.AttributeCaps{Height: 84, DrivingDunk: 20}
+
+	latest, err := badges.NewCalculator(badges.WithRequirementsDir(dir, ""))
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "1.10.json"), latest.Source())
+	tier, err := latest.GetBadgeTier("Aerial Wizard", attrs)
+	require.NoError(t, err)
+	assert.Equal(t, badges.BadgeTierBronze, tier)
+
+	older, err := badges.NewCalculator(badges.WithRequirementsDir(dir, "1.9"))
+	require.NoError(t, err)
+	assert.NotEqual(t, latest.DataVersion(), older.DataVersion())
+	tier, err = older.GetBadgeTier("Aerial Wizard", attrs)
+	require.NoError(t, err)
+	assert.Equal(t, badges.BadgeTierNone, tier)
+
+	_, err = badges.NewCalculator(badges.WithRequirementsDir(t.TempDir(), ""))
+	assert.Error(t, err, "empty directory has no versions")
+}
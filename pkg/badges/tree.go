@@ -0,0 +1,161 @@
+package badges
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// RequirementGroup is a node in a badge's requirement tree
+// Members are the group's rows and subgroups. Every Primary member must be met,
+// and if the group has Secondary members at least one of them must also be met,
+// so "Driving Dunk (Primary), Vertical and Strength (Secondary)" reads as
+// "Driving Dunk AND (Vertical OR Strength)".
+type RequirementGroup struct {
+	// Name is the group path ("" for the badge's top level, "/" separates nested groups)
+	Name string
+	// Type is how the group combines with its siblings in the parent group
+	Type BadgeType
+	// Requirements are the group's own rows
+	Requirements []AttributeRequirement
+	// Groups are the nested subgroups
+	Groups []*RequirementGroup
+}
+
+// BadgeEvaluation is a badge's tier for a build and the branch that met each tier
+type BadgeEvaluation struct {
+	// Name is the badge name
+	Name string
+	// Tier is the tier the build earns
+	Tier BadgeTier
+	// Branches lists the attributes that met each tier, indexed by BadgeTier (empty above Tier)
+	Branches [BadgeTierLegendary + 1][]string
+}
+
+// Branch returns the attributes that met a tier joined with " + " ("" if not met)
+func (e *BadgeEvaluation) Branch(tier BadgeTier) string {
+	if tier < BadgeTierNone || tier > BadgeTierLegendary {
+		return ""
+	}
+	return strings.Join(e.Branches[tier], " + ")
+}
+
+// buildRequirementTree groups a badge's rows by their Group path
+// Rows without a Type take the badge's type; groups without a GroupType are Primary.
+func buildRequirementTree(badgeType BadgeType, requirements []AttributeRequirement) (*RequirementGroup, error) {
+	root := &RequirementGroup{Type: BadgeTypePrimary}
+	groupTypes := make(map[string]string) // group path → GroupType of its first row
+
+	for _, req := range requirements {
+		if req.Type != "" {
+			if _, err := ParseBadgeType(req.Type); err != nil {
+				return nil, fmt.Errorf("%s requirement: %w", req.Attribute, err)
+			}
+		} else {
+			req.Type = badgeType.String()
+		}
+
+		group := root
+		if req.Group != "" {
+			if first, seen := groupTypes[req.Group]; seen && first != req.GroupType {
+				return nil, fmt.Errorf("group %q has rows with group types %q and %q", req.Group, first, req.GroupType)
+			}
+			groupTypes[req.Group] = req.GroupType
+
+			for _, name := range strings.Split(req.Group, "/") {
+				group = group.child(name)
+			}
+			if req.GroupType != "" {
+				groupType, err := ParseBadgeType(req.GroupType)
+				if err != nil {
+					return nil, fmt.Errorf("group %q: %w", req.Group, err)
+				}
+				group.Type = groupType
+			}
+		}
+
+		group.Requirements = append(group.Requirements, req)
+	}
+
+	return root, nil
+}
+
+// child returns the named subgroup, creating it as Primary if needed
+func (g *RequirementGroup) child(name string) *RequirementGroup {
+	path := name
+	if g.Name != "" {
+		path = g.Name + "/" + name
+	}
+	for _, sub := range g.Groups {
+		if sub.Name == path {
+			return sub
+		}
+	}
+
+	sub := &RequirementGroup{Name: path, Type: BadgeTypePrimary}
+	g.Groups = append(g.Groups, sub)
+	return sub
+}
+
+// groupMember is a row or subgroup's result within its parent
+type groupMember struct {
+	kind     BadgeType
+	tier     BadgeTier
+	branches [BadgeTierLegendary + 1][]string
+}
+
+// evaluate returns the group's tier and the attributes that met each tier
+func (c *Calculator) evaluate(g *RequirementGroup, attrs *scraper.AttributeCaps) groupMember {
+	var members []groupMember
+
+	for _, choice := range selectBands(g.Requirements, attrs.Height) {
+		kind, _ := ParseBadgeType(choice.req.Type)
+		m := groupMember{kind: kind}
+		if choice.ok {
+			m.tier = c.getTierForRequirement(choice.req, c.getAttributeValue(choice.req.Attribute, attrs))
+			for t := BadgeTierBronze; t <= m.tier; t++ {
+				m.branches[t] = []string{choice.req.Attribute}
+			}
+		}
+		members = append(members, m)
+	}
+	for _, sub := range g.Groups {
+		m := c.evaluate(sub, attrs)
+		m.kind = sub.Type
+		members = append(members, m)
+	}
+
+	if len(members) == 0 {
+		return groupMember{kind: g.Type}
+	}
+
+	result := groupMember{kind: g.Type, tier: BadgeTierLegendary}
+	var best *groupMember // the Secondary member with the highest tier (nil if none)
+	for i := range members {
+		m := &members[i]
+		if m.kind == BadgeTypePrimary {
+			result.tier = min(result.tier, m.tier)
+			continue
+		}
+		if best == nil || m.tier > best.tier {
+			best = m
+		}
+	}
+	if best != nil {
+		result.tier = min(result.tier, best.tier)
+	}
+
+	for t := BadgeTierBronze; t <= result.tier; t++ {
+		for _, m := range members {
+			if m.kind == BadgeTypePrimary {
+				result.branches[t] = append(result.branches[t], m.branches[t]...)
+			}
+		}
+		if best != nil {
+			result.branches[t] = append(result.branches[t], best.branches[t]...)
+		}
+	}
+
+	return result
+}
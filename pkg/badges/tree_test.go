@@ -0,0 +1,150 @@
+package badges
+
+import (
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// treeCalculator returns a calculator with two compound badges:
+// Lift Off: Driving Dunk AND (Vertical OR Strength)
+// Either Way: (Block AND Vertical) OR Steal
+func treeCalculator(t *testing.T) *Calculator {
+	t.Helper()
+
+	reqs := map[string]*BadgeRequirements{
+		"LiftOff": {
+			Name:     "Lift Off",
+			Category: "Inside Scoring",
+			Type:     "Primary",
+			Requirements: []AttributeRequirement{
+				{Attribute: "Driving Dunk", Bronze: 60, Silver: 70, Gold: 80, HallOfFame: 90, Legendary: 95, Type: "Primary"},
+				{Attribute: "Vertical", Bronze: 60, Silver: 70, Gold: 80, HallOfFame: 90, Legendary: 95, Type: "Secondary", Group: "lift"},
+				{Attribute: "Strength", Bronze: 60, Silver: 70, Gold: 80, HallOfFame: 90, Legendary: 95, Type: "Secondary", Group: "lift"},
+			},
+		},
+		"EitherWay": {
+			Name:     "Either Way",
+			Category: "Defense",
+			Type:     "Secondary",
+			Requirements: []AttributeRequirement{
+				{Attribute: "Block", Bronze: 60, Silver: 70, Gold: 80, Type: "Primary", Group: "rim", GroupType: "Secondary"},
+				{Attribute: "Vertical", Bronze: 60, Silver: 70, Gold: 80, Type: "Primary", Group: "rim", GroupType: "Secondary"},
+				{Attribute: "Steal", Bronze: 60, Silver: 70, Gold: 80},
+			},
+		},
+	}
+
+	calc, err := newCalculator(reqs, nil)
+	require.NoError(t, err)
+	return calc
+}
+
+// TestEvaluateBadge_Tree tests nested groups combine each row by its own type
+func TestEvaluateBadge_Tree(t *testing.T) {
+	calc := treeCalculator(t)
+
+	tests := []struct {
+		name         string
+		badge        string
+		attrs        scraper.AttributeCaps
+		expectedTier BadgeTier
+		branch       string
+	}{
+		{"Dunk with Vertical", "Lift Off", scraper.AttributeCaps{DrivingDunk: 85, Vertical: 72, Strength: 40}, BadgeTierSilver, "Driving Dunk + Vertical"},
+		{"Dunk with Strength", "Lift Off", scraper.AttributeCaps{DrivingDunk: 85, Vertical: 40, Strength: 92}, BadgeTierGold, "Driving Dunk + Strength"},
+		{"No lift", "Lift Off", scraper.AttributeCaps{DrivingDunk: 99, Vertical: 40, Strength: 40}, BadgeTierNone, ""},
+		{"Rim pair", "Either Way", scraper.AttributeCaps{Block: 82, Vertical: 75, Steal: 50}, BadgeTierSilver, "Block + Vertical"},
+		{"Steal alone", "Either Way", scraper.AttributeCaps{Block: 99, Vertical: 30, Steal: 81}, BadgeTierGold, "Steal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := tt.attrs
+			attrs.Height = 84
+			eval, err := calc.EvaluateBadge(tt.badge, &attrs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTier, eval.Tier)
+			assert.Equal(t, tt.branch, eval.Branch(eval.Tier))
+
+			tier, err := calc.GetBadgeTier(tt.badge, &attrs)
+			require.NoError(t, err)
+			assert.Equal(t, eval.Tier, tier)
+		})
+	}
+}
+
+// TestEvaluateBadge_BranchPerTier tests lower tiers can be met by a different branch
+func TestEvaluateBadge_BranchPerTier(t *testing.T) {
+	calc := treeCalculator(t)
+
+	// Steal reaches Gold on its own; the rim pair only reaches Bronze
+	attrs := &scraper.AttributeCaps{Height: 84, Block: 65, Vertical: 90, Steal: 80}
+	eval, err := calc.EvaluateBadge("Either Way", attrs)
+	require.NoError(t, err)
+	assert.Equal(t, BadgeTierGold, eval.Tier)
+	assert.Equal(t, "Steal", eval.Branch(BadgeTierBronze))
+	assert.Equal(t, "Steal", eval.Branch(BadgeTierGold))
+	assert.Empty(t, eval.Branch(BadgeTierHallOfFame))
+}
+
+// TestExplainBadge_Tree tests the bottleneck and next tier follow the cheapest branch
+func TestExplainBadge_Tree(t *testing.T) {
+	calc := treeCalculator(t)
+
+	// Silver via Driving Dunk + Vertical; Gold needs Vertical 80, not Strength 80
+	attrs := &scraper.AttributeCaps{Height: 84, DrivingDunk: 85, Vertical: 75, Strength: 40}
+	exp, err := calc.ExplainBadge("Lift Off", attrs)
+	require.NoError(t, err)
+	assert.Equal(t, BadgeTierSilver, exp.Tier)
+	assert.Equal(t, BadgeTierGold, exp.NextTier)
+	assert.Equal(t, "Vertical", exp.Bottleneck)
+	assert.Equal(t, 5, exp.PointsToNextTier)
+	assert.Equal(t, []AttributeGap{{Attribute: "Vertical", Value: 75, Needed: 80}}, exp.NextTierGaps)
+
+	// No tier yet: Steal alone is closer to Bronze than the rim pair
+	attrs = &scraper.AttributeCaps{Height: 84, Block: 65, Vertical: 50, Steal: 55}
+	exp, err = calc.ExplainBadge("Either Way", attrs)
+	require.NoError(t, err)
+	assert.Equal(t, BadgeTierNone, exp.Tier)
+	assert.Equal(t, "Steal", exp.BestPath)
+	assert.Empty(t, exp.Bottleneck)
+	assert.Equal(t, 5, exp.PointsToNextTier)
+
+	// Gold via Steal; no branch has a Hall of Fame threshold
+	attrs = &scraper.AttributeCaps{Height: 84, Block: 65, Vertical: 90, Steal: 80}
+	exp, err = calc.ExplainBadge("Either Way", attrs)
+	require.NoError(t, err)
+	assert.Equal(t, "Steal", exp.BestPath)
+	assert.Equal(t, BadgeTierNone, exp.NextTier)
+	assert.Empty(t, exp.NextTierGaps)
+}
+
+// TestBuildRequirementTree tests rows are grouped by path and inherit the badge type
+func TestBuildRequirementTree(t *testing.T) {
+	tree, err := buildRequirementTree(BadgeTypeSecondary, []AttributeRequirement{
+		{Attribute: "Steal"},
+		{Attribute: "Block", Group: "rim/high"},
+		{Attribute: "Vertical", Group: "rim/high"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, tree.Requirements, 1)
+	assert.Equal(t, "Secondary", tree.Requirements[0].Type)
+	require.Len(t, tree.Groups, 1)
+	assert.Equal(t, "rim", tree.Groups[0].Name)
+	require.Len(t, tree.Groups[0].Groups, 1)
+	assert.Equal(t, "rim/high", tree.Groups[0].Groups[0].Name)
+	assert.Len(t, tree.Groups[0].Groups[0].Requirements, 2)
+
+	_, err = buildRequirementTree(BadgeTypePrimary, []AttributeRequirement{
+		{Attribute: "Block", Group: "rim", GroupType: "Primary"},
+		{Attribute: "Vertical", Group: "rim", GroupType: "Secondary"},
+	})
+	assert.Error(t, err, "rows of one group must agree on its type")
+
+	_, err = buildRequirementTree(BadgeTypePrimary, []AttributeRequirement{{Attribute: "Block", Type: "Tertiary"}})
+	assert.Error(t, err)
+}
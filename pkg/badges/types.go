@@ -1,6 +1,10 @@
 package badges
 
-import "github.com/jredh-dev/nba2k26/pkg/scraper"
+import (
+	"fmt"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
 
 // BadgeTier represents the tier level of a badge
 type BadgeTier int
@@ -20,9 +24,54 @@ const (
 	BadgeTierLegendary
 )
 
-// String returns the string representation of a BadgeTier
+// tierNames are the display names indexed by BadgeTier
+var tierNames = [...]string{"None", "Bronze", "Silver", "Gold", "Hall of Fame", "Legendary"}
+
+// String returns the string representation of a BadgeTier ("BadgeTier(7)" if out of range)
 func (b BadgeTier) String() string {
-	return [...]string{"None", "Bronze", "Silver", "Gold", "Hall of Fame", "Legendary"}[b]
+	if b < BadgeTierNone || b > BadgeTierLegendary {
+		return fmt.Sprintf("BadgeTier(%d)", int(b))
+	}
+	return tierNames[b]
+}
+
+// ParseBadgeTier converts a tier name to a BadgeTier, ignoring case and punctuation
+// Accepts the display names plus "HoF" and "Legend".
+func ParseBadgeTier(s string) (BadgeTier, error) {
+	switch normalizeBadgeName(s) {
+	case "none":
+		return BadgeTierNone, nil
+	case "bronze":
+		return BadgeTierBronze, nil
+	case "silver":
+		return BadgeTierSilver, nil
+	case "gold":
+		return BadgeTierGold, nil
+	case "hof", "halloffame":
+		return BadgeTierHallOfFame, nil
+	case "legendary", "legend":
+		return BadgeTierLegendary, nil
+	default:
+		return BadgeTierNone, fmt.Errorf("unknown badge tier %q (use None, Bronze, Silver, Gold, HoF, or Legendary)", s)
+	}
+}
+
+// MarshalText encodes the tier as its display name, so JSON and YAML show "Gold"
+func (b BadgeTier) MarshalText() ([]byte, error) {
+	if b < BadgeTierNone || b > BadgeTierLegendary {
+		return nil, fmt.Errorf("invalid badge tier %d", int(b))
+	}
+	return []byte(tierNames[b]), nil
+}
+
+// UnmarshalText decodes a tier name accepted by ParseBadgeTier
+func (b *BadgeTier) UnmarshalText(text []byte) error {
+	tier, err := ParseBadgeTier(string(text))
+	if err != nil {
+		return err
+	}
+	*b = tier
+	return nil
 }
 
 // BadgeFunc is a function that calculates the maximum tier for a badge based on attribute caps
@@ -48,28 +97,128 @@ const (
 	BadgeCategoryAllAround
 )
 
-// String returns the string representation of a BadgeCategory
+// categoryNames are the display names indexed by BadgeCategory
+var categoryNames = [...]string{
+	"Finishing",
+	"Shooting",
+	"Playmaking",
+	"Defense",
+	"Rebounding",
+	"Physicals",
+	"All-Around",
+}
+
+// String returns the string representation of a BadgeCategory ("BadgeCategory(7)" if out of range)
 func (c BadgeCategory) String() string {
-	return [...]string{
-		"Finishing",
-		"Shooting",
-		"Playmaking",
-		"Defense",
-		"Rebounding",
-		"Physicals",
-		"All-Around",
-	}[c]
+	if c < BadgeCategoryFinishing || c > BadgeCategoryAllAround {
+		return fmt.Sprintf("BadgeCategory(%d)", int(c))
+	}
+	return categoryNames[c]
+}
+
+// categorySourceNames maps each BadgeCategory to its NBA2KLab category string
+var categorySourceNames = map[BadgeCategory]string{
+	BadgeCategoryFinishing:  "Inside Scoring",
+	BadgeCategoryShooting:   "Outside Scoring",
+	BadgeCategoryPlaymaking: "Playmaking",
+	BadgeCategoryDefense:    "Defense",
+	BadgeCategoryRebounding: "Rebounding",
+	BadgeCategoryPhysicals:  "General Offense",
+	BadgeCategoryAllAround:  "All Around",
+}
+
+// SourceName returns the NBA2KLab category string (e.g., "Inside Scoring")
+func (c BadgeCategory) SourceName() string {
+	return categorySourceNames[c]
+}
+
+// ParseBadgeCategory converts an NBA2KLab category string to a BadgeCategory
+func ParseBadgeCategory(s string) (BadgeCategory, error) {
+	for cat, name := range categorySourceNames {
+		if name == s {
+			return cat, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown badge category %q", s)
+}
+
+// MarshalText encodes the category as its name (e.g., "Finishing")
+func (c BadgeCategory) MarshalText() ([]byte, error) {
+	if c < BadgeCategoryFinishing || c > BadgeCategoryAllAround {
+		return nil, fmt.Errorf("invalid badge category %d", int(c))
+	}
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText decodes a category name or NBA2KLab category string
+func (c *BadgeCategory) UnmarshalText(text []byte) error {
+	for cat := BadgeCategoryFinishing; cat <= BadgeCategoryAllAround; cat++ {
+		if normalizeBadgeName(cat.String()) == normalizeBadgeName(string(text)) {
+			*c = cat
+			return nil
+		}
+	}
+	cat, err := ParseBadgeCategory(string(text))
+	if err != nil {
+		return err
+	}
+	*c = cat
+	return nil
+}
+
+// BadgeType indicates how a badge's requirements combine
+type BadgeType int
+
+const (
+	// BadgeTypePrimary requires ALL requirements to be met
+	BadgeTypePrimary BadgeType = iota
+	// BadgeTypeSecondary requires ANY requirement to be met
+	BadgeTypeSecondary
+)
+
+// typeNames are the display names indexed by BadgeType
+var typeNames = [...]string{"Primary", "Secondary"}
+
+// String returns the string representation of a BadgeType ("BadgeType(2)" if out of range)
+func (t BadgeType) String() string {
+	if t < BadgeTypePrimary || t > BadgeTypeSecondary {
+		return fmt.Sprintf("BadgeType(%d)", int(t))
+	}
+	return typeNames[t]
+}
+
+// ParseBadgeType converts "Primary" or "Secondary" to a BadgeType
+func ParseBadgeType(s string) (BadgeType, error) {
+	switch s {
+	case "Primary":
+		return BadgeTypePrimary, nil
+	case "Secondary":
+		return BadgeTypeSecondary, nil
+	default:
+		return 0, fmt.Errorf("unknown badge type %q", s)
+	}
 }
 
 // Badge represents a badge with its metadata and calculation function
 type Badge struct {
+	// ID is the badge identifier from NBA2KLab data (e.g., "Post-UpPoet")
+	ID string
 	// Name is the display name of the badge
 	Name string
 	// Category is the badge category (Finishing, Shooting, etc.)
 	Category BadgeCategory
+	// Type indicates if requirements are all-of (Primary) or any-of (Secondary)
+	// Rows may override it; see Tree
+	Type BadgeType
 	// Description is a short description of what the badge does
 	Description string
-	// Calc is the function that calculates the maximum tier for this badge
+	// Requirements is the list of attribute requirements
+	// For code-defined badges they are optional and only used for explanations
+	Requirements []AttributeRequirement
+	// Tree groups the requirements by each row's Type and Group (nil for code-defined badges)
+	Tree *RequirementGroup
+	// Calc calculates the maximum tier for code-defined badges (see Register);
+	// nil for badges defined by JSON requirements
 	Calc BadgeFunc
 }
 
@@ -91,6 +240,33 @@ type AttributeRequirement struct {
 	MinHeight int
 	// MaxHeight is the maximum height requirement in inches (0 if no restriction)
 	MaxHeight int
+	// Type is "Primary" (must be met) or "Secondary" (one of the group's Secondary rows
+	// must be met); empty means the badge's type
+	Type string
+	// Group is the requirement group path (e.g., "lift" or "lift/power"; empty for the top level)
+	Group string
+	// GroupType is how the group combines with its siblings ("Primary" if empty)
+	GroupType string
+}
+
+// AppliesTo reports whether the requirement's height band includes a height
+func (r AttributeRequirement) AppliesTo(heightInches int) bool {
+	if r.MinHeight > 0 && heightInches < r.MinHeight {
+		return false
+	}
+	if r.MaxHeight > 0 && heightInches > r.MaxHeight {
+		return false
+	}
+	return true
+}
+
+// bandWidth returns the span of the requirement's height band (an open max counts as very wide)
+func (r AttributeRequirement) bandWidth() int {
+	maxHeight := r.MaxHeight
+	if maxHeight == 0 {
+		maxHeight = 1000
+	}
+	return maxHeight - r.MinHeight
 }
 
 // BadgeRequirements represents all requirements for a badge
@@ -100,7 +276,9 @@ type BadgeRequirements struct {
 	// Category is the badge category from NBA2KLab data
 	Category string
 	// Type indicates if requirements are "Primary" (all must be met) or "Secondary" (any can be met)
+	// Taken from the badge's first row; each row's own Type takes precedence
 	Type string
 	// Requirements is the list of attribute requirements
+	// An attribute may appear in several rows with different height bands
 	Requirements []AttributeRequirement
 }
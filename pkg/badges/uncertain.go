@@ -0,0 +1,201 @@
+package badges
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// maxCapCombinations bounds how many cap combinations one badge may enumerate
+const maxCapCombinations = 1 << 20
+
+// CapRange is an attribute cap that is only known to lie in a range
+type CapRange struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+	// Odds optionally weights specific values (value → relative weight) and then
+	// replaces Min and Max; without it every value from Min to Max is equally likely
+	Odds map[int]float64 `json:"odds,omitempty"`
+}
+
+// ExactCap returns a range holding a single known value
+func ExactCap(value int) CapRange {
+	return CapRange{Min: value, Max: value}
+}
+
+// capOutcome is one possible cap value and its probability
+type capOutcome struct {
+	value int
+	p     float64
+}
+
+// outcomes returns the range's values with probabilities summing to 1
+func (r CapRange) outcomes() ([]capOutcome, error) {
+	if len(r.Odds) == 0 {
+		if r.Min > r.Max || r.Min < 0 || r.Max > maxRating {
+			return nil, fmt.Errorf("invalid cap range %d-%d", r.Min, r.Max)
+		}
+		n := r.Max - r.Min + 1
+		result := make([]capOutcome, 0, n)
+		for v := r.Min; v <= r.Max; v++ {
+			result = append(result, capOutcome{v, 1 / float64(n)})
+		}
+		return result, nil
+	}
+
+	total := 0.0
+	for v, w := range r.Odds {
+		if v < 0 || v > maxRating || w < 0 {
+			return nil, fmt.Errorf("invalid cap odds %d: %g", v, w)
+		}
+		total += w
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("cap odds have no weight")
+	}
+	var result []capOutcome
+	for _, v := range slices.Sorted(maps.Keys(r.Odds)) {
+		if r.Odds[v] > 0 {
+			result = append(result, capOutcome{v, r.Odds[v] / total})
+		}
+	}
+	return result, nil
+}
+
+// UncertainCaps are a build's caps where some attributes are ranges
+type UncertainCaps struct {
+	// Caps holds the build's measurements and the caps that are known
+	Caps scraper.AttributeCaps
+	// Ranges overrides Caps for uncertain attributes, keyed by scraper.AttributeNames
+	Ranges map[string]CapRange
+}
+
+// TierOdds is a badge's tier under uncertain caps
+type TierOdds struct {
+	// Badge is the badge name
+	Badge string
+	// Guaranteed is the tier the build earns whatever the uncertain caps turn out
+	// to be, and Best the tier it earns if they all land favorably
+	Guaranteed BadgeTier
+	Best       BadgeTier
+	// Probabilities is the chance of each tier exactly, indexed by BadgeTier
+	Probabilities [BadgeTierLegendary + 1]float64
+	// Uncertain lists the ranged attributes the badge depends on, sorted
+	Uncertain []string
+}
+
+// Certain reports whether the tier does not depend on any uncertain cap
+func (o TierOdds) Certain() bool {
+	return o.Guaranteed == o.Best
+}
+
+// AtLeast returns the chance of earning the tier or higher
+func (o TierOdds) AtLeast(tier BadgeTier) float64 {
+	total := 0.0
+	for t := max(tier, BadgeTierNone); t <= BadgeTierLegendary; t++ {
+		total += o.Probabilities[t]
+	}
+	return total
+}
+
+// String formats the odds as "Posterizer: Silver guaranteed, Gold 40% (inferred: Vertical)"
+func (o TierOdds) String() string {
+	if o.Certain() {
+		return fmt.Sprintf("%s: %s", o.Badge, o.Guaranteed)
+	}
+	parts := []string{fmt.Sprintf("%s guaranteed", o.Guaranteed)}
+	for t := o.Guaranteed + 1; t <= o.Best; t++ {
+		parts = append(parts, fmt.Sprintf("%s %.0f%%", t, 100*o.AtLeast(t)))
+	}
+	return fmt.Sprintf("%s: %s (inferred: %s)", o.Badge, strings.Join(parts, ", "), strings.Join(o.Uncertain, ", "))
+}
+
+// TierOdds evaluates a badge over every combination of the uncertain caps it
+// depends on, treating the ranges as independent
+// Code-defined badges may read any attribute, so every ranged attribute counts.
+func (c *Calculator) TierOdds(badgeName string, caps *UncertainCaps) (*TierOdds, error) {
+	id, exists := c.lookupID(badgeName)
+	if !exists {
+		return nil, c.notFound(badgeName)
+	}
+	badge := c.badges[id]
+
+	uses := func(string) bool { return true }
+	if badge.Calc == nil {
+		needed := make(map[string]bool)
+		for _, req := range badge.Requirements {
+			needed[canonicalAttribute(req.Attribute)] = true
+		}
+		uses = func(name string) bool { return needed[name] }
+	}
+
+	odds := &TierOdds{Badge: badge.Name, Guaranteed: BadgeTierLegendary, Best: BadgeTierNone}
+	var names []string
+	var outcomes [][]capOutcome
+	combinations := 1
+	for _, name := range slices.Sorted(maps.Keys(caps.Ranges)) {
+		if _, ok := caps.Caps.Get(name); !ok {
+			return nil, fmt.Errorf("unknown attribute %q in cap ranges", name)
+		}
+		if !uses(name) {
+			continue
+		}
+		o, err := caps.Ranges[name].outcomes()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		names = append(names, name)
+		outcomes = append(outcomes, o)
+		if len(o) > 1 {
+			odds.Uncertain = append(odds.Uncertain, name)
+		}
+		combinations *= len(o)
+		if combinations > maxCapCombinations {
+			return nil, fmt.Errorf("%s: too many cap combinations; narrow the ranges", badge.Name)
+		}
+	}
+
+	attrs := caps.Caps
+	var walk func(i int, p float64) error
+	walk = func(i int, p float64) error {
+		if i == len(names) {
+			tier, err := c.GetBadgeTier(id, &attrs)
+			if err != nil {
+				return err
+			}
+			odds.Probabilities[tier] += p
+			odds.Guaranteed = min(odds.Guaranteed, tier)
+			odds.Best = max(odds.Best, tier)
+			return nil
+		}
+		for _, o := range outcomes[i] {
+			attrs.Set(names[i], o.value)
+			if err := walk(i+1, p*o.p); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(0, 1); err != nil {
+		return nil, err
+	}
+
+	return odds, nil
+}
+
+// AllTierOdds evaluates every badge under uncertain caps, ordered by category then name
+func (c *Calculator) AllTierOdds(caps *UncertainCaps) ([]TierOdds, error) {
+	all := c.Badges()
+	result := make([]TierOdds, 0, len(all))
+	for _, b := range all {
+		odds, err := c.TierOdds(b.ID, caps)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *odds)
+	}
+	return result, nil
+}
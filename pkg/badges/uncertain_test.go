@@ -0,0 +1,67 @@
+package badges_test
+
+import (
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTierOdds tests guaranteed and best-case tiers with their probabilities
+func TestTierOdds(t *testing.T) {
+	calc := testCalculator(t)
+
+	caps := &badges.UncertainCaps{
+		Caps: scraper.AttributeCaps{DrivingDunk: 85, Steal: 72},
+		Ranges: map[string]badges.CapRange{
+			"Vertical": {Min: 78, Max: 81}, // Silver at 78-79, Gold at 80-81
+			"Steal":    badges.ExactCap(83),
+		},
+	}
+
+	odds, err := calc.TierOdds("Posterizer", caps)
+	require.NoError(t, err)
+	assert.Equal(t, badges.BadgeTierSilver, odds.Guaranteed)
+	assert.Equal(t, badges.BadgeTierGold, odds.Best)
+	assert.False(t, odds.Certain())
+	assert.InDelta(t, 0.5, odds.Probabilities[badges.BadgeTierSilver], 1e-9)
+	assert.InDelta(t, 0.5, odds.AtLeast(badges.BadgeTierGold), 1e-9)
+	assert.InDelta(t, 1.0, odds.AtLeast(badges.BadgeTierBronze), 1e-9)
+	assert.Equal(t, []string{"Vertical"}, odds.Uncertain, "Steal does not affect Posterizer")
+	assert.Equal(t, "Posterizer: Silver guaranteed, Gold 50% (inferred: Vertical)", odds.String())
+
+	// The exact Steal range overrides the known cap
+	glove, err := calc.TierOdds("Glove", caps)
+	require.NoError(t, err)
+	assert.True(t, glove.Certain())
+	assert.Equal(t, badges.BadgeTierGold, glove.Guaranteed)
+	assert.Equal(t, "Glove: Gold", glove.String())
+
+	// Weighted values replace the uniform range
+	caps.Ranges["Vertical"] = badges.CapRange{Odds: map[int]float64{75: 1, 92: 3}}
+	odds, err = calc.TierOdds("Posterizer", caps)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.25, odds.Probabilities[badges.BadgeTierSilver], 1e-9)
+	assert.InDelta(t, 0.75, odds.Probabilities[badges.BadgeTierGold], 1e-9)
+
+	all, err := calc.AllTierOdds(caps)
+	require.NoError(t, err)
+	require.Len(t, all, 8)
+	assert.Equal(t, "Posterizer", all[0].Badge)
+}
+
+// TestTierOdds_Invalid tests bad ranges and attribute names are rejected
+func TestTierOdds_Invalid(t *testing.T) {
+	calc := testCalculator(t)
+
+	_, err := calc.TierOdds("Posterizer", &badges.UncertainCaps{Ranges: map[string]badges.CapRange{"Vertical": {Min: 90, Max: 80}}})
+	assert.Error(t, err)
+
+	_, err = calc.TierOdds("Posterizer", &badges.UncertainCaps{Ranges: map[string]badges.CapRange{"Jumping": {Min: 80, Max: 90}}})
+	assert.ErrorContains(t, err, "unknown attribute")
+
+	_, err = calc.TierOdds("Posterizer", &badges.UncertainCaps{Ranges: map[string]badges.CapRange{"Vertical": {Odds: map[int]float64{80: 0}}}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,267 @@
+package badges
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// maxRating is the highest attribute rating in the game
+const maxRating = 99
+
+// CurrentBadges returns the badges a player's current ratings earn (tier > None)
+// current holds the ratings today and caps the build's caps; the build's
+// measurements come from caps. A cap of 0 means the attribute is not modeled and
+// is not checked.
+func (c *Calculator) CurrentBadges(current, caps *scraper.AttributeCaps) (map[string]BadgeTier, error) {
+	ratings, err := currentRatings(current, caps)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetAvailableBadges(ratings), nil
+}
+
+// currentRatings checks ratings against caps and copies the build's measurements onto them
+func currentRatings(current, caps *scraper.AttributeCaps) (*scraper.AttributeCaps, error) {
+	for _, name := range scraper.AttributeNames {
+		value, _ := current.Get(name)
+		limit, _ := caps.Get(name)
+		if limit > 0 && value > limit {
+			return nil, fmt.Errorf("%s rating %d exceeds its cap %d", name, value, limit)
+		}
+	}
+
+	ratings := *current
+	ratings.Position = caps.Position
+	ratings.Height = caps.Height
+	ratings.Wingspan = caps.Wingspan
+	ratings.Weight = caps.Weight
+	return &ratings, nil
+}
+
+// Upgrade raises one attribute
+type Upgrade struct {
+	// Attribute is the attribute name (see scraper.AttributeNames)
+	Attribute string
+	// From is the current rating and To the rating to reach
+	From int
+	To   int
+	// Cap is the build's cap (0 if the attribute is not modeled, so To is unverified)
+	Cap int
+}
+
+// Points returns how many rating points the upgrade needs
+func (u Upgrade) Points() int {
+	return u.To - u.From
+}
+
+// String formats the upgrade as "Driving Dunk 72 → 80 (+8)"
+func (u Upgrade) String() string {
+	s := fmt.Sprintf("%s %d → %d (+%d)", u.Attribute, u.From, u.To, u.Points())
+	if u.Cap == 0 {
+		s += " [cap not modeled]"
+	}
+	return s
+}
+
+// UpgradePlan is the fewest rating points that reach a set of badge tiers
+type UpgradePlan struct {
+	// Upgrades lists the attributes to raise in scraper.AttributeNames order
+	Upgrades []Upgrade
+	// Points is the total rating points across Upgrades
+	Points int
+	// Ratings are the ratings after every upgrade
+	Ratings scraper.AttributeCaps
+	// Tiers are the targeted badges' tiers after every upgrade
+	Tiers map[string]BadgeTier
+}
+
+// PlanUpgrades finds the minimum-points upgrade from current ratings that reaches
+// every target badge tier without raising an attribute past its cap
+// Secondary requirements are alternatives, so the plan picks whichever branch of
+// each badge is cheapest together with the others. Attributes whose cap is 0
+// (not modeled) may be raised up to 99 and are marked in the plan.
+func (c *Calculator) PlanUpgrades(current, caps *scraper.AttributeCaps, targets map[string]BadgeTier) (*UpgradePlan, error) {
+	ratings, err := currentRatings(current, caps)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := func(name string) int {
+		if v, _ := caps.Get(name); v > 0 {
+			return v
+		}
+		return maxRating
+	}
+
+	// Every way to meet each target within the caps
+	names := slices.Sorted(maps.Keys(targets))
+	options := make([][]requirementSet, len(names))
+	for i, name := range names {
+		id, exists := c.lookupID(name)
+		if !exists {
+			return nil, c.notFound(name)
+		}
+		badge := c.badges[id]
+
+		if targets[name] <= BadgeTierNone {
+			options[i] = []requirementSet{{}} // nothing to reach
+			continue
+		}
+		if badge.Calc != nil {
+			return nil, fmt.Errorf("%s is code-defined; its requirements cannot be planned", badge.Name)
+		}
+		for _, set := range c.alternatives(badge.Tree, targets[name], ratings.Height) {
+			if set.within(limit) {
+				options[i] = append(options[i], set)
+			}
+		}
+		if len(options[i]) == 0 {
+			return nil, fmt.Errorf("%s %s is out of reach within this build's caps", badge.Name, targets[name])
+		}
+	}
+
+	// Branch and bound over one option per target
+	var best requirementSet
+	bestCost := -1
+	var search func(i int, acc requirementSet)
+	search = func(i int, acc requirementSet) {
+		cost := acc.cost(ratings)
+		if bestCost >= 0 && cost >= bestCost {
+			return
+		}
+		if i == len(options) {
+			best, bestCost = acc, cost
+			return
+		}
+		for _, set := range options[i] {
+			search(i+1, acc.merge(set))
+		}
+	}
+	search(0, requirementSet{})
+
+	plan := &UpgradePlan{Ratings: *ratings, Tiers: make(map[string]BadgeTier, len(names))}
+	for _, name := range scraper.AttributeNames {
+		from, _ := ratings.Get(name)
+		to, ok := best[name]
+		if !ok || to <= from {
+			continue
+		}
+		capValue, _ := caps.Get(name)
+		plan.Upgrades = append(plan.Upgrades, Upgrade{Attribute: name, From: from, To: to, Cap: capValue})
+		plan.Points += to - from
+		plan.Ratings.Set(name, to)
+	}
+
+	for _, name := range names {
+		tier, err := c.GetBadgeTier(name, &plan.Ratings)
+		if err != nil {
+			return nil, err
+		}
+		plan.Tiers[name] = tier
+	}
+
+	return plan, nil
+}
+
+// requirementSet is one way to meet a tier: attribute → minimum rating
+type requirementSet map[string]int
+
+// merge returns the union of two sets, keeping the higher minimum per attribute
+func (s requirementSet) merge(other requirementSet) requirementSet {
+	merged := maps.Clone(s)
+	for name, value := range other {
+		merged[name] = max(merged[name], value)
+	}
+	return merged
+}
+
+// cost returns the rating points needed to meet the set from current ratings
+func (s requirementSet) cost(ratings *scraper.AttributeCaps) int {
+	total := 0
+	for name, value := range s {
+		if current, _ := ratings.Get(name); value > current {
+			total += value - current
+		}
+	}
+	return total
+}
+
+// within reports whether every minimum in the set is at or below its limit
+func (s requirementSet) within(limit func(string) int) bool {
+	for name, value := range s {
+		if value > limit(name) {
+			return false
+		}
+	}
+	return true
+}
+
+// key returns a canonical string for deduplicating sets
+func (s requirementSet) key() string {
+	var parts []string
+	for _, name := range slices.Sorted(maps.Keys(s)) {
+		parts = append(parts, fmt.Sprintf("%s=%d", name, s[name]))
+	}
+	return strings.Join(parts, ",")
+}
+
+// alternatives expands a requirement group into every set of minimum ratings
+// that meets a tier (an OR of ANDs); an empty result means the tier is unavailable
+func (c *Calculator) alternatives(g *RequirementGroup, tier BadgeTier, height int) []requirementSet {
+	var primary [][]requirementSet
+	var secondary []requirementSet
+	hasSecondary := false
+
+	add := func(kind BadgeType, sets []requirementSet) {
+		if kind == BadgeTypePrimary {
+			primary = append(primary, sets)
+		} else {
+			hasSecondary = true
+			secondary = append(secondary, sets...)
+		}
+	}
+
+	for _, choice := range selectBands(g.Requirements, height) {
+		kind, _ := ParseBadgeType(choice.req.Type)
+		var sets []requirementSet
+		if threshold := choice.req.Threshold(tier); choice.ok && threshold > 0 {
+			sets = []requirementSet{{canonicalAttribute(choice.req.Attribute): threshold}}
+		}
+		add(kind, sets)
+	}
+	for _, sub := range g.Groups {
+		add(sub.Type, c.alternatives(sub, tier, height))
+	}
+
+	if len(primary) == 0 && !hasSecondary {
+		return nil
+	}
+
+	// Every Primary member AND any one Secondary member
+	if hasSecondary {
+		primary = append(primary, secondary)
+	}
+	result := []requirementSet{{}}
+	for _, sets := range primary {
+		var next []requirementSet
+		seen := make(map[string]bool)
+		for _, acc := range result {
+			for _, set := range sets {
+				merged := acc.merge(set)
+				if key := merged.key(); !seen[key] {
+					seen[key] = true
+					next = append(next, merged)
+				}
+			}
+		}
+		result = next
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].key() < result[j].key() })
+	return result
+}
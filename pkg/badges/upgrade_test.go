@@ -0,0 +1,75 @@
+package badges_test
+
+import (
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCurrentBadges tests current ratings are evaluated and checked against caps
+func TestCurrentBadges(t *testing.T) {
+	calc := testCalculator(t)
+	caps := &scraper.AttributeCaps{Height: 84, Steal: 85, Block: 90, Vertical: 80}
+
+	tiers, err := calc.CurrentBadges(&scraper.AttributeCaps{Steal: 71, Block: 66, Vertical: 55}, caps)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]badges.BadgeTier{
+		"Glove":     badges.BadgeTierSilver,
+		"Anchor":    badges.BadgeTierBronze,
+		"Disruptor": badges.BadgeTierSilver,
+		"Rim Guard": badges.BadgeTierBronze,
+	}, tiers)
+
+	_, err = calc.CurrentBadges(&scraper.AttributeCaps{Steal: 86}, caps)
+	assert.ErrorContains(t, err, "Steal rating 86 exceeds its cap 85")
+}
+
+// TestPlanUpgrades tests the plan shares points across badges and respects caps
+func TestPlanUpgrades(t *testing.T) {
+	calc := testCalculator(t)
+	caps := &scraper.AttributeCaps{Height: 84, Steal: 85, Block: 90, Vertical: 80}
+	current := &scraper.AttributeCaps{Steal: 70, Block: 60, Vertical: 50}
+
+	// Disruptor Gold alone: Steal +10 is cheaper than Block +22
+	plan, err := calc.PlanUpgrades(current, caps, map[string]badges.BadgeTier{"Disruptor": badges.BadgeTierGold})
+	require.NoError(t, err)
+	assert.Equal(t, []badges.Upgrade{{Attribute: "Steal", From: 70, To: 80, Cap: 85}}, plan.Upgrades)
+	assert.Equal(t, 10, plan.Points)
+
+	// With Rim Guard Gold, Block must reach 85 anyway, which also covers Disruptor Gold
+	plan, err = calc.PlanUpgrades(current, caps, map[string]badges.BadgeTier{
+		"Disruptor": badges.BadgeTierGold,
+		"Rim Guard": badges.BadgeTierGold,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []badges.Upgrade{
+		{Attribute: "Block", From: 60, To: 85, Cap: 90},
+		{Attribute: "Vertical", From: 50, To: 70, Cap: 80},
+	}, plan.Upgrades)
+	assert.Equal(t, 45, plan.Points)
+	assert.Equal(t, badges.BadgeTierGold, plan.Tiers["Disruptor"])
+	assert.Equal(t, badges.BadgeTierGold, plan.Tiers["Rim Guard"])
+	assert.Equal(t, 85, plan.Ratings.Block)
+	assert.Equal(t, "Block 60 → 85 (+25)", plan.Upgrades[0].String())
+
+	// Disruptor Legendary needs Steal 95 or Block 97, both above the caps
+	_, err = calc.PlanUpgrades(current, caps, map[string]badges.BadgeTier{"Disruptor": badges.BadgeTierLegendary})
+	assert.ErrorContains(t, err, "out of reach")
+
+	_, err = calc.PlanUpgrades(current, caps, map[string]badges.BadgeTier{"Nonexistent": badges.BadgeTierBronze})
+	assert.Error(t, err)
+}
+
+// TestPlanUpgrades_UnmodeledCap tests attributes with no cap can be planned up to 99 and are flagged
+func TestPlanUpgrades_UnmodeledCap(t *testing.T) {
+	calc := testCalculator(t)
+	caps := &scraper.AttributeCaps{Height: 84, Block: 90}
+
+	plan, err := calc.PlanUpgrades(&scraper.AttributeCaps{Block: 70}, caps, map[string]badges.BadgeTier{"Rim Guard": badges.BadgeTierBronze})
+	require.NoError(t, err)
+	assert.Equal(t, []badges.Upgrade{{Attribute: "Vertical", From: 0, To: 50, Cap: 0}}, plan.Upgrades)
+	assert.Contains(t, plan.Upgrades[0].String(), "cap not modeled")
+}
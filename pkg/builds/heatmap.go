@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package builds
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jredh-dev/nba2k26/pkg/attributes"
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// NoBuild marks a heatmap cell with no evaluated build (illegal or not in the dataset)
+const NoBuild badges.BadgeTier = -1
+
+// Heatmap is a badge's tier for every build on height × wingspan grids, one per weight slice
+type Heatmap struct {
+	// Badge is the badge name
+	Badge string
+	// MaxTier is the highest tier any evaluated build reaches (not just the slices)
+	MaxTier badges.BadgeTier
+	// Heights are the grid rows and Wingspans the columns, ascending
+	Heights   []int
+	Wingspans []int
+	// Slices holds one grid per requested weight
+	Slices []HeatmapSlice
+}
+
+// HeatmapSlice is the grid of tiers at one weight
+type HeatmapSlice struct {
+	// Weight is the slice's weight in lbs
+	Weight int
+	// Tiers is indexed [height][wingspan] in Heatmap order (NoBuild where no build was evaluated)
+	Tiers [][]badges.BadgeTier
+}
+
+// BadgeHeatmap evaluates a badge for every build and lays the tiers out per weight slice
+// Builds whose weight is not one of weights only count toward MaxTier.
+func BadgeHeatmap(calc *badges.Calculator, caps []scraper.AttributeCaps, badgeName string, weights []int) (*Heatmap, error) {
+	badge, err := calc.Badge(badgeName)
+	if err != nil {
+		return nil, err
+	}
+
+	hm := &Heatmap{Badge: badge.Name}
+	for i := range caps {
+		if !slices.Contains(hm.Heights, caps[i].Height) {
+			hm.Heights = append(hm.Heights, caps[i].Height)
+		}
+		if !slices.Contains(hm.Wingspans, caps[i].Wingspan) {
+			hm.Wingspans = append(hm.Wingspans, caps[i].Wingspan)
+		}
+	}
+	slices.Sort(hm.Heights)
+	slices.Sort(hm.Wingspans)
+
+	sliceIndex := make(map[int]int, len(weights)) // weight → position in hm.Slices
+	for _, w := range weights {
+		if _, dup := sliceIndex[w]; dup {
+			continue
+		}
+		sliceIndex[w] = len(hm.Slices)
+		grid := make([][]badges.BadgeTier, len(hm.Heights))
+		for h := range grid {
+			grid[h] = make([]badges.BadgeTier, len(hm.Wingspans))
+			for ws := range grid[h] {
+				grid[h][ws] = NoBuild
+			}
+		}
+		hm.Slices = append(hm.Slices, HeatmapSlice{Weight: w, Tiers: grid})
+	}
+
+	for i := range caps {
+		c := &caps[i]
+		tier, err := calc.GetBadgeTier(badgeName, c)
+		if err != nil {
+			return nil, err
+		}
+		hm.MaxTier = max(hm.MaxTier, tier)
+
+		s, ok := sliceIndex[c.Weight]
+		if !ok {
+			continue
+		}
+		h, _ := slices.BinarySearch(hm.Heights, c.Height)
+		ws, _ := slices.BinarySearch(hm.Wingspans, c.Wingspan)
+		hm.Slices[s].Tiers[h][ws] = tier
+	}
+
+	return hm, nil
+}
+
+// tierSymbols are the one-letter heatmap cells indexed by BadgeTier
+var tierSymbols = [...]string{"-", "B", "S", "G", "H", "L"}
+
+// TierSymbol returns a tier's heatmap cell: "-" None, B/S/G/H/L, or "·" for NoBuild
+func TierSymbol(tier badges.BadgeTier) string {
+	if tier < badges.BadgeTierNone || tier > badges.BadgeTierLegendary {
+		return "·"
+	}
+	return tierSymbols[tier]
+}
+
+// String renders every slice as a text grid with heights down and wingspans across
+func (hm *Heatmap) String() string {
+	var sb strings.Builder
+	for _, s := range hm.Slices {
+		fmt.Fprintf(&sb, "%s @ %d lbs\n", hm.Badge, s.Weight)
+
+		sb.WriteString("       ")
+		for _, ws := range hm.Wingspans {
+			fmt.Fprintf(&sb, "%3d", ws)
+		}
+		sb.WriteString("  (wingspan, inches)\n")
+
+		for h, height := range hm.Heights {
+			fmt.Fprintf(&sb, "%-6s ", attributes.InchesToLength(height))
+			for ws := range hm.Wingspans {
+				fmt.Fprintf(&sb, "%3s", TierSymbol(s.Tiers[h][ws]))
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package builds_test
+
+import (
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/builds"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBadgeHeatmap tests tiers land in their height × wingspan cell per weight slice
+func TestBadgeHeatmap(t *testing.T) {
+	calc := testCalculator(t)
+
+	caps := []scraper.AttributeCaps{
+		{Height: 84, Wingspan: 86, Weight: 240, Steal: 82},
+		{Height: 84, Wingspan: 88, Weight: 240, Steal: 50},
+		{Height: 85, Wingspan: 88, Weight: 240, Steal: 71},
+		{Height: 85, Wingspan: 88, Weight: 260, Steal: 96}, // not a slice weight
+	}
+
+	hm, err := builds.BadgeHeatmap(calc, caps, "Glove", []int{240})
+	require.NoError(t, err)
+	assert.Equal(t, badges.BadgeTierLegendary, hm.MaxTier, "every build counts toward MaxTier")
+	assert.Equal(t, []int{84, 85}, hm.Heights)
+	assert.Equal(t, []int{86, 88}, hm.Wingspans)
+	require.Len(t, hm.Slices, 1)
+	assert.Equal(t, [][]badges.BadgeTier{
+		{badges.BadgeTierGold, badges.BadgeTierNone},
+		{builds.NoBuild, badges.BadgeTierSilver},
+	}, hm.Slices[0].Tiers)
+
+	assert.Equal(t, "Glove @ 240 lbs\n"+
+		"        86 88  (wingspan, inches)\n"+
+		"7'0\"     G  -\n"+
+		"7'1\"     ·  S\n\n", hm.String())
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package builds
+
+import (
+	"github.com/jredh-dev/nba2k26/pkg/attributes"
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// BadgeImpact is how a requirements update changes one badge's tiers across builds
+type BadgeImpact struct {
+	// Badge is the badge name (from the new data when present in both)
+	Badge string
+	// Gained and Lost list the builds whose tier rose or fell, in build order
+	Gained []BuildChange
+	Lost   []BuildChange
+}
+
+// BuildChange is one build's badge tier under the old and new requirements
+type BuildChange struct {
+	Build attributes.Build
+	From  badges.BadgeTier
+	To    badges.BadgeTier
+}
+
+// RequirementsImpact evaluates every build under both calculators and lists, per
+// badge, the builds that gain or lose tiers
+// A badge missing from one version counts as None there. Badges whose tiers do
+// not change for any build are omitted; the rest follow the new data's order,
+// then removed badges.
+func RequirementsImpact(old, new *badges.Calculator, caps []scraper.AttributeCaps) ([]BadgeImpact, error) {
+	var names []string
+	seen := make(map[string]bool)
+	for _, calc := range []*badges.Calculator{new, old} {
+		for _, b := range calc.Badges() {
+			if !seen[b.ID] {
+				seen[b.ID] = true
+				names = append(names, b.ID)
+			}
+		}
+	}
+
+	var impacts []BadgeImpact
+	for _, id := range names {
+		impact := BadgeImpact{}
+		for _, calc := range []*badges.Calculator{old, new} {
+			if b, err := calc.Badge(id); err == nil {
+				impact.Badge = b.Name
+			}
+		}
+
+		for i := range caps {
+			c := &caps[i]
+			from, err := tierIn(old, id, c)
+			if err != nil {
+				return nil, err
+			}
+			to, err := tierIn(new, id, c)
+			if err != nil {
+				return nil, err
+			}
+
+			change := BuildChange{
+				Build: attributes.Build{Height: c.Height, Wingspan: c.Wingspan, Weight: c.Weight},
+				From:  from,
+				To:    to,
+			}
+			switch {
+			case to > from:
+				impact.Gained = append(impact.Gained, change)
+			case to < from:
+				impact.Lost = append(impact.Lost, change)
+			}
+		}
+
+		if len(impact.Gained) > 0 || len(impact.Lost) > 0 {
+			impacts = append(impacts, impact)
+		}
+	}
+	return impacts, nil
+}
+
+// tierIn returns a badge's tier for a build, or None if the calculator lacks the badge
+func tierIn(calc *badges.Calculator, id string, c *scraper.AttributeCaps) (badges.BadgeTier, error) {
+	if _, err := calc.Badge(id); err != nil {
+		return badges.BadgeTierNone, nil
+	}
+	return calc.GetBadgeTier(id, c)
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package builds_test
+
+import (
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/attributes"
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/builds"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRequirementsImpact tests builds gaining and losing tiers are listed per badge
+func TestRequirementsImpact(t *testing.T) {
+	// The updated sheet raises Glove Silver to 75 and adds Boxout Beast
+	old := testCalculator(t)
+	updated, err := badges.NewCalculator(badges.WithRequirementsFile("../badges/testdata/sheet_updated.json"))
+	require.NoError(t, err)
+
+	caps := []scraper.AttributeCaps{
+		{Height: 80, Wingspan: 84, Weight: 220, Steal: 72, Block: 90, Strength: 50},
+		{Height: 84, Wingspan: 88, Weight: 250, Steal: 76, Block: 70, Strength: 82},
+	}
+
+	impacts, err := builds.RequirementsImpact(old, updated, caps)
+	require.NoError(t, err)
+	require.Len(t, impacts, 2) // the other badges are unchanged
+
+	assert.Equal(t, "Glove", impacts[0].Badge)
+	assert.Empty(t, impacts[0].Gained)
+	assert.Equal(t, []builds.BuildChange{{
+		Build: attributes.Build{Height: 80, Wingspan: 84, Weight: 220},
+		From:  badges.BadgeTierSilver,
+		To:    badges.BadgeTierBronze,
+	}}, impacts[0].Lost)
+
+	assert.Equal(t, "Boxout Beast", impacts[1].Badge)
+	assert.Empty(t, impacts[1].Lost)
+	assert.Equal(t, []builds.BuildChange{{
+		Build: attributes.Build{Height: 84, Wingspan: 88, Weight: 250},
+		From:  badges.BadgeTierNone,
+		To:    badges.BadgeTierGold,
+	}}, impacts[1].Gained)
+}
@@ -0,0 +1,333 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package builds
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/jredh-dev/nba2k26/pkg/attributes"
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// ErrStaleIndex is returned when an index was built from different requirements or caps
+var ErrStaleIndex = errors.New("badge index is stale")
+
+// Index holds every badge's tier for every build, precomputed for fast queries
+// An index is tied to the requirements it was built from (DataVersion) and the
+// caps it evaluated (CapsVersion); ReadIndex rejects one that no longer matches.
+type Index struct {
+	// DataVersion is the calculator's DataVersion when the index was built
+	DataVersion string
+	// CapsVersion is a content hash of the evaluated caps (see CapsVersion)
+	CapsVersion string
+
+	calc   *badges.Calculator
+	badges []string           // badge names in calc.Badges() order
+	builds []attributes.Build // in caps order
+	tiers  [][]badges.BadgeTier
+	lookup map[attributes.Build]int // build → position in builds
+	// ranked holds, per badge, the build positions ordered by tier (highest first)
+	// and starts[t] the number of builds at tier t or higher, so the builds
+	// reaching a tier are a prefix of ranked
+	ranked [][]int
+	starts [][badges.BadgeTierLegendary + 2]int
+}
+
+// Requirement is one condition of an index query ("Legendary Anchor")
+type Requirement struct {
+	// Badge is the badge name or ID
+	Badge string
+	// Tier is the lowest tier that satisfies the requirement
+	Tier badges.BadgeTier
+}
+
+// String formats the requirement as "Legendary Anchor"
+func (r Requirement) String() string {
+	return fmt.Sprintf("%s %s", r.Tier, r.Badge)
+}
+
+// CapsVersion returns a short content hash of a caps dataset
+func CapsVersion(caps []scraper.AttributeCaps) string {
+	data, _ := json.Marshal(caps) // AttributeCaps is plain data and always marshals
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// BuildIndex evaluates every badge for every build
+// A build listed more than once keeps its first caps.
+func BuildIndex(calc *badges.Calculator, caps []scraper.AttributeCaps) (*Index, error) {
+	all := calc.Badges()
+	ix := &Index{
+		DataVersion: calc.DataVersion(),
+		CapsVersion: CapsVersion(caps),
+		calc:        calc,
+		badges:      make([]string, len(all)),
+	}
+	for i, b := range all {
+		ix.badges[i] = b.Name
+	}
+
+	seen := make(map[attributes.Build]bool, len(caps))
+	for i := range caps {
+		build := buildOf(&caps[i])
+		if seen[build] {
+			continue
+		}
+		seen[build] = true
+		tiers, err := buildTiers(calc, all, &caps[i])
+		if err != nil {
+			return nil, err
+		}
+		ix.builds = append(ix.builds, build)
+		ix.tiers = append(ix.tiers, tiers)
+	}
+
+	ix.rank()
+	return ix, nil
+}
+
+// rank builds the lookup and per-badge orderings from builds and tiers
+func (ix *Index) rank() {
+	ix.lookup = make(map[attributes.Build]int, len(ix.builds))
+	for i, b := range ix.builds {
+		ix.lookup[b] = i
+	}
+
+	ix.ranked = make([][]int, len(ix.badges))
+	ix.starts = make([][badges.BadgeTierLegendary + 2]int, len(ix.badges))
+	for bi := range ix.badges {
+		var counts [badges.BadgeTierLegendary + 1]int
+		for _, tiers := range ix.tiers {
+			counts[tiers[bi]]++
+		}
+		// starts[t] counts builds at tier t or higher; starts[Legendary+1] is 0
+		for t := badges.BadgeTierLegendary; t >= badges.BadgeTierNone; t-- {
+			ix.starts[bi][t] = ix.starts[bi][t+1] + counts[t]
+		}
+
+		ranked := make([]int, len(ix.builds))
+		for i := range ranked {
+			ranked[i] = i
+		}
+		sort.SliceStable(ranked, func(i, j int) bool {
+			return ix.tiers[ranked[i]][bi] > ix.tiers[ranked[j]][bi]
+		})
+		ix.ranked[bi] = ranked
+	}
+}
+
+// Len returns the number of indexed builds
+func (ix *Index) Len() int {
+	return len(ix.builds)
+}
+
+// Builds returns the indexed builds in the order they were evaluated
+func (ix *Index) Builds() []attributes.Build {
+	return append([]attributes.Build(nil), ix.builds...)
+}
+
+// Tiers returns every badge's tier for a build, keyed by badge name
+func (ix *Index) Tiers(build attributes.Build) (map[string]badges.BadgeTier, error) {
+	i, ok := ix.lookup[build]
+	if !ok {
+		return nil, fmt.Errorf("build %s is not in the index", build)
+	}
+	result := make(map[string]badges.BadgeTier, len(ix.badges))
+	for bi, name := range ix.badges {
+		result[name] = ix.tiers[i][bi]
+	}
+	return result, nil
+}
+
+// Count returns how many builds reach a badge tier
+func (ix *Index) Count(badgeName string, tier badges.BadgeTier) (int, error) {
+	bi, err := ix.badgeIndex(badgeName)
+	if err != nil {
+		return 0, err
+	}
+	return ix.starts[bi][clampTier(tier)], nil
+}
+
+// Query returns the builds that meet every requirement, in the order they were evaluated
+func (ix *Index) Query(reqs ...Requirement) ([]attributes.Build, error) {
+	if len(reqs) == 0 {
+		return ix.Builds(), nil
+	}
+
+	type condition struct {
+		badge int
+		tier  badges.BadgeTier
+	}
+	conds := make([]condition, len(reqs))
+	for i, r := range reqs {
+		bi, err := ix.badgeIndex(r.Badge)
+		if err != nil {
+			return nil, err
+		}
+		conds[i] = condition{bi, clampTier(r.Tier)}
+	}
+
+	// Walk the rarest requirement's builds and check the rest directly
+	sort.SliceStable(conds, func(i, j int) bool {
+		return ix.starts[conds[i].badge][conds[i].tier] < ix.starts[conds[j].badge][conds[j].tier]
+	})
+	first := conds[0]
+	var matches []int
+candidates:
+	for _, i := range ix.ranked[first.badge][:ix.starts[first.badge][first.tier]] {
+		for _, c := range conds[1:] {
+			if ix.tiers[i][c.badge] < c.tier {
+				continue candidates
+			}
+		}
+		matches = append(matches, i)
+	}
+
+	sort.Ints(matches)
+	result := make([]attributes.Build, len(matches))
+	for k, i := range matches {
+		result[k] = ix.builds[i]
+	}
+	return result, nil
+}
+
+// badgeIndex resolves a badge name or ID to its column
+func (ix *Index) badgeIndex(badgeName string) (int, error) {
+	b, err := ix.calc.Badge(badgeName)
+	if err != nil {
+		return 0, err
+	}
+	for bi, name := range ix.badges {
+		if name == b.Name {
+			return bi, nil
+		}
+	}
+	return 0, fmt.Errorf("badge %q is not in the index", b.Name)
+}
+
+// clampTier keeps a query tier within None..Legendary
+func clampTier(t badges.BadgeTier) badges.BadgeTier {
+	return min(max(t, badges.BadgeTierNone), badges.BadgeTierLegendary)
+}
+
+// indexFile is the on-disk form of an Index
+// Each build's tiers are one digit per badge (0 None through 5 Legendary), in
+// the order of Badges.
+type indexFile struct {
+	DataVersion string       `json:"data_version"`
+	CapsVersion string       `json:"caps_version"`
+	Badges      []string     `json:"badges"`
+	Builds      []indexEntry `json:"builds"`
+}
+
+// indexEntry is one build's row in an index file
+type indexEntry struct {
+	attributes.Build
+	Tiers string `json:"tiers"`
+}
+
+// Encode writes the index as JSON
+func (ix *Index) Encode(w io.Writer) error {
+	file := indexFile{
+		DataVersion: ix.DataVersion,
+		CapsVersion: ix.CapsVersion,
+		Badges:      ix.badges,
+		Builds:      make([]indexEntry, len(ix.builds)),
+	}
+	for i, b := range ix.builds {
+		digits := make([]byte, len(ix.badges))
+		for bi, tier := range ix.tiers[i] {
+			digits[bi] = '0' + byte(tier)
+		}
+		file.Builds[i] = indexEntry{Build: b, Tiers: string(digits)}
+	}
+	return json.NewEncoder(w).Encode(file)
+}
+
+// ReadIndex reads an index written by Encode for use with calc
+// It returns an error wrapping ErrStaleIndex if the index was built from
+// requirements with a different DataVersion.
+func ReadIndex(r io.Reader, calc *badges.Calculator) (*Index, error) {
+	var file indexFile
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("failed to parse badge index: %w", err)
+	}
+	if file.DataVersion != calc.DataVersion() {
+		return nil, fmt.Errorf("%w: built from requirements %s, calculator has %s", ErrStaleIndex, file.DataVersion, calc.DataVersion())
+	}
+
+	ix := &Index{
+		DataVersion: file.DataVersion,
+		CapsVersion: file.CapsVersion,
+		calc:        calc,
+		badges:      file.Badges,
+		builds:      make([]attributes.Build, len(file.Builds)),
+		tiers:       make([][]badges.BadgeTier, len(file.Builds)),
+	}
+	for i, e := range file.Builds {
+		if len(e.Tiers) != len(file.Badges) {
+			return nil, fmt.Errorf("badge index build %s has %d tiers for %d badges", e.Build, len(e.Tiers), len(file.Badges))
+		}
+		tiers := make([]badges.BadgeTier, len(e.Tiers))
+		for bi := range tiers {
+			d := e.Tiers[bi]
+			if d < '0' || d > '0'+byte(badges.BadgeTierLegendary) {
+				return nil, fmt.Errorf("badge index build %s has invalid tier %q", e.Build, d)
+			}
+			tiers[bi] = badges.BadgeTier(d - '0')
+		}
+		ix.builds[i] = e.Build
+		ix.tiers[i] = tiers
+	}
+
+	ix.rank()
+	return ix, nil
+}
+
+// LoadOrBuildIndex reads the index at path, rebuilding and saving it when it is
+// missing or was built from different requirements or caps
+// The boolean reports whether the index was rebuilt.
+func LoadOrBuildIndex(path string, calc *badges.Calculator, caps []scraper.AttributeCaps) (*Index, bool, error) {
+	if f, err := os.Open(path); err == nil {
+		ix, err := ReadIndex(f, calc)
+		f.Close()
+		switch {
+		case err == nil && ix.CapsVersion == CapsVersion(caps):
+			return ix, false, nil
+		case err != nil && !errors.Is(err, ErrStaleIndex):
+			return nil, false, fmt.Errorf("%s: %w", path, err)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, false, fmt.Errorf("failed to read badge index: %w", err)
+	}
+
+	ix, err := BuildIndex(calc, caps)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, false, fmt.Errorf("failed to create directory for badge index: %w", err)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to write badge index: %w", err)
+	}
+	if err := ix.Encode(f); err != nil {
+		f.Close()
+		return nil, false, fmt.Errorf("failed to write badge index: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, false, fmt.Errorf("failed to write badge index: %w", err)
+	}
+	return ix, true, nil
+}
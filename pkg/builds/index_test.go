@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package builds_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/attributes"
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/builds"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIndex tests queries intersect badge tiers across the indexed builds
+func TestIndex(t *testing.T) {
+	calc := testCalculator(t)
+
+	ix, err := builds.BuildIndex(calc, lineupCaps)
+	require.NoError(t, err)
+	assert.Equal(t, 4, ix.Len())
+	assert.Equal(t, calc.DataVersion(), ix.DataVersion)
+
+	// Anchor: Bronze, Gold, Legendary, None; Glove: HoF, Silver, None, None
+	got, err := ix.Query(builds.Requirement{Badge: "anchor", Tier: badges.BadgeTierBronze})
+	require.NoError(t, err)
+	assert.Equal(t, []attributes.Build{lineupBuild(0), lineupBuild(1), lineupBuild(2)}, got)
+
+	got, err = ix.Query(
+		builds.Requirement{Badge: "Anchor", Tier: badges.BadgeTierGold},
+		builds.Requirement{Badge: "Glove", Tier: badges.BadgeTierSilver},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []attributes.Build{lineupBuild(1)}, got)
+
+	got, err = ix.Query(builds.Requirement{Badge: "Dimer", Tier: badges.BadgeTierLegendary}, builds.Requirement{Badge: "Glove", Tier: badges.BadgeTierBronze})
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	n, err := ix.Count("Dimer", badges.BadgeTierSilver)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	tiers, err := ix.Tiers(lineupBuild(3))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]badges.BadgeTier{
+		"Posterizer": badges.BadgeTierSilver, "Dimer": badges.BadgeTierLegendary,
+		"Glove": badges.BadgeTierNone, "Anchor": badges.BadgeTierNone, "Interceptor": badges.BadgeTierNone,
+		"Disruptor": badges.BadgeTierNone, "Rim Guard": badges.BadgeTierNone, "Rebound Chaser": badges.BadgeTierNone,
+	}, tiers)
+
+	_, err = ix.Query(builds.Requirement{Badge: "Glvoe", Tier: badges.BadgeTierGold})
+	var notFound *badges.NotFoundError
+	assert.ErrorAs(t, err, &notFound)
+
+	_, err = ix.Tiers(attributes.Build{Height: 70, Wingspan: 70, Weight: 180})
+	assert.Error(t, err)
+}
+
+// TestIndex_Encode tests an index round-trips and is rejected by other requirements
+func TestIndex_Encode(t *testing.T) {
+	calc := testCalculator(t)
+	ix, err := builds.BuildIndex(calc, lineupCaps)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, ix.Encode(&buf))
+	data := buf.Bytes()
+
+	read, err := builds.ReadIndex(bytes.NewReader(data), calc)
+	require.NoError(t, err)
+	assert.Equal(t, ix.Builds(), read.Builds())
+	assert.Equal(t, ix.CapsVersion, read.CapsVersion)
+	for _, b := range ix.Builds() {
+		want, _ := ix.Tiers(b)
+		got, err := read.Tiers(b)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+
+	other, err := badges.NewCalculator()
+	require.NoError(t, err)
+	_, err = builds.ReadIndex(bytes.NewReader(data), other)
+	assert.ErrorIs(t, err, builds.ErrStaleIndex)
+
+	_, err = builds.ReadIndex(strings.NewReader(`{"data_version": "`+calc.DataVersion()+`", "badges": ["Glove"], "builds": [{"height": 80, "tiers": "7"}]}`), calc)
+	assert.Error(t, err)
+}
+
+// TestLoadOrBuildIndex tests the saved index is reused until the caps change
+func TestLoadOrBuildIndex(t *testing.T) {
+	calc := testCalculator(t)
+	path := filepath.Join(t.TempDir(), "index", "badges.json")
+
+	_, rebuilt, err := builds.LoadOrBuildIndex(path, calc, lineupCaps)
+	require.NoError(t, err)
+	assert.True(t, rebuilt)
+
+	ix, rebuilt, err := builds.LoadOrBuildIndex(path, calc, lineupCaps)
+	require.NoError(t, err)
+	assert.False(t, rebuilt)
+	assert.Equal(t, 4, ix.Len())
+
+	fewer := []scraper.AttributeCaps{lineupCaps[0]}
+	ix, rebuilt, err = builds.LoadOrBuildIndex(path, calc, fewer)
+	require.NoError(t, err)
+	assert.True(t, rebuilt)
+	assert.Equal(t, 1, ix.Len())
+}
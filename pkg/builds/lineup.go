@@ -0,0 +1,296 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package builds
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jredh-dev/nba2k26/pkg/attributes"
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// Player is one lineup slot: a position and a build at that position
+// Each slot is evaluated against, and swaps among, the builds of its position.
+type Player struct {
+	Position string
+	Build    attributes.Build
+}
+
+// positionNames maps the short position names to the scraped ones
+var positionNames = map[string]string{
+	"pg": "Point Guard",
+	"sg": "Shooting Guard",
+	"sf": "Small Forward",
+	"pf": "Power Forward",
+	"c":  "Center",
+}
+
+// ParsePlayer parses a player given as position:height/wingspan/weight
+// ("PG:6-3/6-8/190" or "Center:84/87/260"); without a position the build is a Center.
+func ParsePlayer(s string) (Player, error) {
+	position, build := "Center", s
+	if i := strings.Index(s, ":"); i >= 0 {
+		position, build = strings.TrimSpace(s[:i]), s[i+1:]
+		if position == "" {
+			return Player{}, fmt.Errorf("invalid player %q (use position:height/wingspan/weight, e.g. C:7-0/7-3/260)", s)
+		}
+		if name, ok := positionNames[strings.ToLower(position)]; ok {
+			position = name
+		}
+	}
+	b, err := attributes.ParseBuild(build)
+	if err != nil {
+		return Player{}, err
+	}
+	return Player{Position: position, Build: b}, nil
+}
+
+// String returns the player in the format Center 7'0" / 7'3" / 260 lbs
+func (p Player) String() string {
+	return fmt.Sprintf("%s %s", p.Position, p.Build)
+}
+
+// Plays reports whether a set of caps is a build at the player's position
+func (p Player) Plays(c *scraper.AttributeCaps) bool {
+	return strings.EqualFold(c.Position, p.Position)
+}
+
+// playerOf returns the position and measurements of a set of caps
+func playerOf(c *scraper.AttributeCaps) Player {
+	return Player{Position: c.Position, Build: buildOf(c)}
+}
+
+// LineupCoverage is the badges a lineup covers between its players
+type LineupCoverage struct {
+	// Players are the lineup's players, one per slot
+	Players []Player
+	// Badges holds every badge in category then name order
+	Badges []BadgeCoverage
+	// Categories summarizes coverage per category, in category order
+	Categories []CategoryCoverage
+	// Score is the sum of every badge's best tier in the lineup
+	Score int
+}
+
+// BadgeCoverage is one badge's tier on each player of a lineup
+type BadgeCoverage struct {
+	Badge    string
+	Category badges.BadgeCategory
+	// Tiers is indexed by lineup slot
+	Tiers []badges.BadgeTier
+	// Best is the highest tier any player has
+	Best badges.BadgeTier
+}
+
+// Holders returns the slots of the players that have the badge
+func (b BadgeCoverage) Holders() []int {
+	var slots []int
+	for slot, tier := range b.Tiers {
+		if tier > badges.BadgeTierNone {
+			slots = append(slots, slot)
+		}
+	}
+	return slots
+}
+
+// Duplicated reports whether more than one player has the badge
+func (b BadgeCoverage) Duplicated() bool {
+	return len(b.Holders()) > 1
+}
+
+// CategoryCoverage counts the badges of one category the lineup covers
+type CategoryCoverage struct {
+	Category badges.BadgeCategory
+	// Covered is the number of badges at least one player has, out of Total
+	Covered int
+	Total   int
+	// Gaps lists the badges no player has, by name
+	Gaps []string
+}
+
+// Duplicates returns the badges more than one player has
+func (lc *LineupCoverage) Duplicates() []BadgeCoverage {
+	var result []BadgeCoverage
+	for _, b := range lc.Badges {
+		if b.Duplicated() {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// Swap replaces one player's build to raise the lineup's coverage
+type Swap struct {
+	// Slot is the lineup slot whose build changes
+	Slot int
+	From Player
+	To   Player
+	// Gain is the change in LineupCoverage.Score
+	Gain int
+	// Gained and Lost list the lineup's best tiers that rise or fall
+	Gained []BadgeChange
+	Lost   []BadgeChange
+}
+
+// AnalyzeLineup evaluates every badge for each player and combines the coverage
+// Every lineup player must be one of the builds in caps at their position.
+func AnalyzeLineup(calc *badges.Calculator, caps []scraper.AttributeCaps, lineup []Player) (*LineupCoverage, error) {
+	slots, err := lineupIndexes(caps, lineup)
+	if err != nil {
+		return nil, err
+	}
+
+	all := calc.Badges()
+	tiers := make([][]badges.BadgeTier, len(lineup)) // slot → badge index → tier
+	for slot, i := range slots {
+		if tiers[slot], err = buildTiers(calc, all, &caps[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	lc := &LineupCoverage{Players: append([]Player(nil), lineup...)}
+	categories := make(map[badges.BadgeCategory]*CategoryCoverage)
+	for bi, b := range all {
+		cov := BadgeCoverage{Badge: b.Name, Category: b.Category, Tiers: make([]badges.BadgeTier, len(lineup))}
+		for slot := range lineup {
+			cov.Tiers[slot] = tiers[slot][bi]
+			cov.Best = max(cov.Best, cov.Tiers[slot])
+		}
+		lc.Badges = append(lc.Badges, cov)
+		lc.Score += int(cov.Best)
+
+		cat, ok := categories[b.Category]
+		if !ok {
+			cat = &CategoryCoverage{Category: b.Category}
+			categories[b.Category] = cat
+		}
+		cat.Total++
+		if cov.Best > badges.BadgeTierNone {
+			cat.Covered++
+		} else {
+			cat.Gaps = append(cat.Gaps, b.Name)
+		}
+	}
+
+	for _, cat := range categories {
+		lc.Categories = append(lc.Categories, *cat)
+	}
+	sort.Slice(lc.Categories, func(i, j int) bool { return lc.Categories[i].Category < lc.Categories[j].Category })
+	return lc, nil
+}
+
+// SuggestSwaps finds, for each lineup slot, the build in caps at the slot's
+// position that raises the lineup's coverage score the most while the other
+// players stay put
+// Slots with no improving build are left out. Ties go to the swap that lowers
+// fewer badges, then to build order; swaps are ordered by gain, then slot.
+func SuggestSwaps(calc *badges.Calculator, caps []scraper.AttributeCaps, lineup []Player) ([]Swap, error) {
+	slots, err := lineupIndexes(caps, lineup)
+	if err != nil {
+		return nil, err
+	}
+
+	all := calc.Badges()
+	table := make([][]badges.BadgeTier, len(caps)) // build → badge index → tier
+	for i := range caps {
+		if table[i], err = buildTiers(calc, all, &caps[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	var swaps []Swap
+	for slot, current := range slots {
+		// The best tiers of the rest of the lineup, which a swap cannot lower
+		others := make([]badges.BadgeTier, len(all))
+		for s, i := range slots {
+			if s == slot {
+				continue
+			}
+			for bi, tier := range table[i] {
+				others[bi] = max(others[bi], tier)
+			}
+		}
+
+		var best *Swap
+		for i := range caps {
+			if !lineup[slot].Plays(&caps[i]) {
+				continue
+			}
+			swap := Swap{Slot: slot, From: lineup[slot], To: playerOf(&caps[i])}
+			for bi, b := range all {
+				from := max(others[bi], table[current][bi])
+				to := max(others[bi], table[i][bi])
+				swap.Gain += int(to) - int(from)
+				switch {
+				case to > from:
+					swap.Gained = append(swap.Gained, BadgeChange{Badge: b.Name, From: from, To: to})
+				case to < from:
+					swap.Lost = append(swap.Lost, BadgeChange{Badge: b.Name, From: from, To: to})
+				}
+			}
+			if swap.Gain <= 0 {
+				continue
+			}
+			if best == nil || swap.Gain > best.Gain || (swap.Gain == best.Gain && len(swap.Lost) < len(best.Lost)) {
+				best = &swap
+			}
+		}
+		if best != nil {
+			swaps = append(swaps, *best)
+		}
+	}
+
+	sort.SliceStable(swaps, func(i, j int) bool { return swaps[i].Gain > swaps[j].Gain })
+	return swaps, nil
+}
+
+// lineupIndexes finds each lineup player's index in caps
+// Builds are keyed by position as well as measurements, so the same
+// measurements at two positions stay apart.
+func lineupIndexes(caps []scraper.AttributeCaps, lineup []Player) ([]int, error) {
+	if len(lineup) == 0 {
+		return nil, fmt.Errorf("empty lineup")
+	}
+	index := make(map[Player]int, len(caps))
+	for i := range caps {
+		key := playerOf(&caps[i])
+		key.Position = strings.ToLower(key.Position)
+		if _, dup := index[key]; !dup {
+			index[key] = i
+		}
+	}
+
+	slots := make([]int, len(lineup))
+	for slot, p := range lineup {
+		key := p
+		key.Position = strings.ToLower(key.Position)
+		i, ok := index[key]
+		if !ok {
+			return nil, fmt.Errorf("lineup player %s is not among the evaluated builds", p)
+		}
+		slots[slot] = i
+	}
+	return slots, nil
+}
+
+// buildTiers evaluates every badge for one build, in the order of all
+func buildTiers(calc *badges.Calculator, all []badges.Badge, c *scraper.AttributeCaps) ([]badges.BadgeTier, error) {
+	tiers := make([]badges.BadgeTier, len(all))
+	for i, b := range all {
+		tier, err := calc.GetBadgeTier(b.ID, c)
+		if err != nil {
+			return nil, err
+		}
+		tiers[i] = tier
+	}
+	return tiers, nil
+}
+
+// buildOf returns the measurements of a set of caps
+func buildOf(c *scraper.AttributeCaps) attributes.Build {
+	return attributes.Build{Height: c.Height, Wingspan: c.Wingspan, Weight: c.Weight}
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package builds_test
+
+import (
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/attributes"
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/builds"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var lineupCaps = []scraper.AttributeCaps{
+	{Position: "Center", Height: 80, Wingspan: 84, Weight: 220, Steal: 91, Block: 62, DrivingDunk: 85, Vertical: 85}, // Glove and Disruptor HoF, Anchor Bronze, Posterizer Gold
+	{Position: "Center", Height: 84, Wingspan: 88, Weight: 250, Steal: 72, Block: 82},                                // Glove Silver, Anchor and Disruptor Gold
+	{Position: "Center", Height: 88, Wingspan: 92, Weight: 280, Block: 96, PassAccuracy: 75},                         // Anchor Legendary, Disruptor HoF, Dimer Silver
+	{Position: "Center", Height: 82, Wingspan: 86, Weight: 240, PassAccuracy: 96, DrivingDunk: 70, Vertical: 70},     // Dimer Legendary, Posterizer Silver
+}
+
+func lineupBuild(i int) attributes.Build {
+	c := lineupCaps[i]
+	return attributes.Build{Height: c.Height, Wingspan: c.Wingspan, Weight: c.Weight}
+}
+
+func lineupPlayer(i int) builds.Player {
+	return builds.Player{Position: "Center", Build: lineupBuild(i)}
+}
+
+// TestAnalyzeLineup tests coverage, duplicates, and category gaps are combined across players
+func TestAnalyzeLineup(t *testing.T) {
+	calc := testCalculator(t)
+
+	lineup := []builds.Player{lineupPlayer(0), lineupPlayer(1)}
+	lc, err := builds.AnalyzeLineup(calc, lineupCaps, lineup)
+	require.NoError(t, err)
+
+	assert.Equal(t, lineup, lc.Players)
+	assert.Equal(t, []builds.BadgeCoverage{
+		{Badge: "Posterizer", Category: badges.BadgeCategoryFinishing, Tiers: []badges.BadgeTier{badges.BadgeTierGold, badges.BadgeTierNone}, Best: badges.BadgeTierGold},
+		{Badge: "Dimer", Category: badges.BadgeCategoryPlaymaking, Tiers: []badges.BadgeTier{badges.BadgeTierNone, badges.BadgeTierNone}, Best: badges.BadgeTierNone},
+		{Badge: "Anchor", Category: badges.BadgeCategoryDefense, Tiers: []badges.BadgeTier{badges.BadgeTierBronze, badges.BadgeTierGold}, Best: badges.BadgeTierGold},
+		{Badge: "Disruptor", Category: badges.BadgeCategoryDefense, Tiers: []badges.BadgeTier{badges.BadgeTierHallOfFame, badges.BadgeTierGold}, Best: badges.BadgeTierHallOfFame},
+		{Badge: "Glove", Category: badges.BadgeCategoryDefense, Tiers: []badges.BadgeTier{badges.BadgeTierHallOfFame, badges.BadgeTierSilver}, Best: badges.BadgeTierHallOfFame},
+		{Badge: "Interceptor", Category: badges.BadgeCategoryDefense, Tiers: []badges.BadgeTier{badges.BadgeTierNone, badges.BadgeTierNone}, Best: badges.BadgeTierNone},
+		{Badge: "Rim Guard", Category: badges.BadgeCategoryDefense, Tiers: []badges.BadgeTier{badges.BadgeTierNone, badges.BadgeTierNone}, Best: badges.BadgeTierNone},
+		{Badge: "Rebound Chaser", Category: badges.BadgeCategoryRebounding, Tiers: []badges.BadgeTier{badges.BadgeTierNone, badges.BadgeTierNone}, Best: badges.BadgeTierNone},
+	}, lc.Badges)
+	assert.Equal(t, 3+0+3+4+4, lc.Score)
+
+	var duplicated []string
+	for _, b := range lc.Duplicates() {
+		duplicated = append(duplicated, b.Badge)
+	}
+	assert.Equal(t, []string{"Anchor", "Disruptor", "Glove"}, duplicated)
+	assert.Equal(t, []int{0, 1}, lc.Badges[4].Holders())
+
+	assert.Equal(t, []builds.CategoryCoverage{
+		{Category: badges.BadgeCategoryFinishing, Covered: 1, Total: 1},
+		{Category: badges.BadgeCategoryPlaymaking, Covered: 0, Total: 1, Gaps: []string{"Dimer"}},
+		{Category: badges.BadgeCategoryDefense, Covered: 3, Total: 5, Gaps: []string{"Interceptor", "Rim Guard"}},
+		{Category: badges.BadgeCategoryRebounding, Covered: 0, Total: 1, Gaps: []string{"Rebound Chaser"}},
+	}, lc.Categories)
+}
+
+// TestSuggestSwaps tests each slot's best improving build is suggested, largest gain first
+func TestSuggestSwaps(t *testing.T) {
+	calc := testCalculator(t)
+
+	lineup := []builds.Player{lineupPlayer(0), lineupPlayer(1)}
+	swaps, err := builds.SuggestSwaps(calc, lineupCaps, lineup)
+	require.NoError(t, err)
+	require.Len(t, swaps, 2)
+
+	// Slot 1 to the Block/Pass build: Anchor Gold → Legendary (+2), Dimer None → Silver (+2);
+	// its Disruptor HoF matches slot 0's
+	assert.Equal(t, builds.Swap{
+		Slot: 1,
+		From: lineupPlayer(1),
+		To:   lineupPlayer(2),
+		Gain: 4,
+		Gained: []builds.BadgeChange{
+			{Badge: "Dimer", From: badges.BadgeTierNone, To: badges.BadgeTierSilver},
+			{Badge: "Anchor", From: badges.BadgeTierGold, To: badges.BadgeTierLegendary},
+		},
+	}, swaps[0])
+
+	// Slot 0 to the Pass build: Dimer +5 outweighs Glove -2, Posterizer -1, and Disruptor -1
+	assert.Equal(t, 0, swaps[1].Slot)
+	assert.Equal(t, lineupPlayer(3), swaps[1].To)
+	assert.Equal(t, 1, swaps[1].Gain)
+	assert.Equal(t, []builds.BadgeChange{
+		{Badge: "Posterizer", From: badges.BadgeTierGold, To: badges.BadgeTierSilver},
+		{Badge: "Disruptor", From: badges.BadgeTierHallOfFame, To: badges.BadgeTierGold},
+		{Badge: "Glove", From: badges.BadgeTierHallOfFame, To: badges.BadgeTierSilver},
+	}, swaps[1].Lost)
+}
+
+// TestAnalyzeLineup_Errors tests lineups are validated against the evaluated builds
+func TestAnalyzeLineup_Errors(t *testing.T) {
+	calc := testCalculator(t)
+
+	_, err := builds.AnalyzeLineup(calc, lineupCaps, nil)
+	assert.Error(t, err)
+
+	_, err = builds.SuggestSwaps(calc, lineupCaps, []builds.Player{{Position: "Center", Build: attributes.Build{Height: 70, Wingspan: 70, Weight: 180}}})
+	assert.ErrorContains(t, err, "not among the evaluated builds")
+
+	_, err = builds.AnalyzeLineup(calc, lineupCaps, []builds.Player{{Position: "Point Guard", Build: lineupBuild(0)}})
+	assert.ErrorContains(t, err, "not among the evaluated builds")
+}
+
+// TestLineup_Positions tests builds are keyed by position and each slot swaps within its own
+func TestLineup_Positions(t *testing.T) {
+	calc := testCalculator(t)
+
+	// A Point Guard with the first Center's measurements but different caps
+	guard := scraper.AttributeCaps{Position: "Point Guard", Height: 80, Wingspan: 84, Weight: 220, PassAccuracy: 62}
+	caps := append([]scraper.AttributeCaps{guard}, lineupCaps...)
+
+	lineup := []builds.Player{lineupPlayer(0), {Position: "point guard", Build: lineupBuild(0)}}
+	lc, err := builds.AnalyzeLineup(calc, caps, lineup)
+	require.NoError(t, err)
+	assert.Equal(t, []badges.BadgeTier{badges.BadgeTierGold, badges.BadgeTierNone}, lc.Badges[0].Tiers, "Posterizer")
+	assert.Equal(t, []badges.BadgeTier{badges.BadgeTierNone, badges.BadgeTierBronze}, lc.Badges[1].Tiers, "Dimer")
+
+	// Any Center build would raise the guard's slot, but the guard is the only
+	// Point Guard build, so only the Center slot swaps
+	lineup[0] = lineupPlayer(1)
+	swaps, err := builds.SuggestSwaps(calc, caps, lineup)
+	require.NoError(t, err)
+	require.NotEmpty(t, swaps)
+	for _, s := range swaps {
+		assert.Equal(t, 0, s.Slot)
+		assert.Equal(t, "Center", s.To.Position)
+	}
+}
+
+// TestParsePlayer tests positions are optional and short names expand
+func TestParsePlayer(t *testing.T) {
+	p, err := builds.ParsePlayer("7-0/7-3/260")
+	require.NoError(t, err)
+	assert.Equal(t, builds.Player{Position: "Center", Build: attributes.Build{Height: 84, Wingspan: 87, Weight: 260}}, p)
+
+	p, err = builds.ParsePlayer("pg:6-3/6-8/190")
+	require.NoError(t, err)
+	assert.Equal(t, builds.Player{Position: "Point Guard", Build: attributes.Build{Height: 75, Wingspan: 80, Weight: 190}}, p)
+	assert.Equal(t, `Point Guard 6'3" / 6'8" / 190 lbs`, p.String())
+
+	_, err = builds.ParsePlayer(":7-0/7-3/260")
+	assert.Error(t, err)
+	_, err = builds.ParsePlayer("C:7-0/260")
+	assert.Error(t, err)
+}
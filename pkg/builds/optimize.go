@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package builds
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/jredh-dev/nba2k26/pkg/attributes"
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// Target is a badge wishlist entry ("Gold Posterizer")
+// Targets are weighted preferences: a build below a target's tier still
+// ranks, with partial credit, unless the target is Required.
+type Target struct {
+	// Badge is the badge name
+	Badge string
+	// Tier is the tier wanted; higher tiers earn no extra credit
+	Tier badges.BadgeTier
+	// Weight scales the target's share of the score (1 if zero)
+	Weight float64
+	// Required makes Tier a hard minimum: builds below it are left out
+	Required bool
+}
+
+// Candidate is a build ranked by how well it meets the targets
+type Candidate struct {
+	Build attributes.Build
+	// Score is the sum over targets of Weight × min(tier, Tier) / Tier
+	Score float64
+	// Tiers holds every badge's tier for the build, keyed by badge name
+	Tiers map[string]badges.BadgeTier
+	// Gained and Lost list the badges whose tier rises or falls compared with the baseline build
+	Gained []BadgeChange
+	Lost   []BadgeChange
+}
+
+// BadgeChange is one badge's tier on the baseline build and on a candidate
+type BadgeChange struct {
+	Badge string
+	From  badges.BadgeTier
+	To    badges.BadgeTier
+}
+
+// String formats the change as "Posterizer Bronze → Gold"
+func (c BadgeChange) String() string {
+	return fmt.Sprintf("%s %s → %s", c.Badge, c.From, c.To)
+}
+
+// Optimize scores every build against weighted badge targets and returns the top builds
+// Builds below a Required target's tier are left out, so the result may be
+// empty. Ties are broken by the total tiers across every badge, then by build
+// order. When baseline is non-nil each candidate lists the badges gained and
+// lost compared with it; a baseline that is not among the builds in caps (an
+// off-step weight, say) is evaluated from attributes.CenterCaps.
+func Optimize(calc *badges.Calculator, caps []scraper.AttributeCaps, targets []Target, baseline *attributes.Build, top int) ([]Candidate, error) {
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no badge targets")
+	}
+	// Resolve IDs and loose spellings to display names, which key the tier maps
+	targets = append([]Target(nil), targets...)
+	for i := range targets {
+		t := &targets[i]
+		b, err := calc.Badge(t.Badge)
+		if err != nil {
+			return nil, err
+		}
+		t.Badge = b.Name
+		if t.Tier <= badges.BadgeTierNone || t.Tier > badges.BadgeTierLegendary {
+			return nil, fmt.Errorf("%s: target tier must be Bronze through Legendary", t.Badge)
+		}
+	}
+
+	all := calc.Badges()
+	var base map[string]badges.BadgeTier
+	evaluate := func(c *scraper.AttributeCaps) (map[string]badges.BadgeTier, int, error) {
+		tiers := make(map[string]badges.BadgeTier, len(all))
+		total := 0
+		for _, b := range all {
+			tier, err := calc.GetBadgeTier(b.Name, c)
+			if err != nil {
+				return nil, 0, err
+			}
+			tiers[b.Name] = tier
+			total += int(tier)
+		}
+		return tiers, total, nil
+	}
+
+	type scored struct {
+		Candidate
+		total int // tiers summed across every badge
+		order int
+	}
+	ranked := make([]scored, 0, len(caps))
+
+	for i := range caps {
+		tiers, total, err := evaluate(&caps[i])
+		if err != nil {
+			return nil, err
+		}
+
+		build := buildOf(&caps[i])
+		if baseline != nil && build == *baseline && base == nil {
+			base = tiers
+		}
+		if !meetsRequired(targets, tiers) {
+			continue
+		}
+
+		ranked = append(ranked, scored{
+			Candidate: Candidate{Build: build, Score: score(targets, tiers), Tiers: tiers},
+			total:     total,
+			order:     i,
+		})
+	}
+
+	if baseline != nil && base == nil {
+		if !attributes.IsLegalCenterBuild(*baseline) {
+			return nil, fmt.Errorf("baseline build %s is not a legal Center build", baseline)
+		}
+		var err error
+		if base, _, err = evaluate(attributes.CenterCaps(baseline.Height, baseline.Weight, baseline.Wingspan)); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.total != b.total {
+			return a.total > b.total
+		}
+		return a.order < b.order
+	})
+
+	if top <= 0 || top > len(ranked) {
+		top = len(ranked)
+	}
+	result := make([]Candidate, top)
+	for i := range result {
+		result[i] = ranked[i].Candidate
+		if base != nil {
+			result[i].Gained, result[i].Lost = compareTiers(all, base, result[i].Tiers)
+		}
+	}
+	return result, nil
+}
+
+// score sums each target's weighted share of its wanted tier
+func score(targets []Target, tiers map[string]badges.BadgeTier) float64 {
+	total := 0.0
+	for _, t := range targets {
+		weight := t.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		total += weight * float64(min(tiers[t.Badge], t.Tier)) / float64(t.Tier)
+	}
+	return total
+}
+
+// meetsRequired reports whether tiers reach every Required target
+func meetsRequired(targets []Target, tiers map[string]badges.BadgeTier) bool {
+	for _, t := range targets {
+		if t.Required && tiers[t.Badge] < t.Tier {
+			return false
+		}
+	}
+	return true
+}
+
+// compareTiers lists badges whose tier rose or fell from base to tiers, in badge order
+func compareTiers(all []badges.Badge, base, tiers map[string]badges.BadgeTier) (gained, lost []BadgeChange) {
+	for _, b := range all {
+		from, to := base[b.Name], tiers[b.Name]
+		switch {
+		case to > from:
+			gained = append(gained, BadgeChange{Badge: b.Name, From: from, To: to})
+		case to < from:
+			lost = append(lost, BadgeChange{Badge: b.Name, From: from, To: to})
+		}
+	}
+	return gained, lost
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package builds_test
+
+import (
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/attributes"
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/builds"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOptimize tests builds are ranked by weighted targets with changes against the baseline
+func TestOptimize(t *testing.T) {
+	calc := testCalculator(t)
+
+	caps := []scraper.AttributeCaps{
+		{Height: 80, Wingspan: 84, Weight: 220, Steal: 91, Block: 40}, // Glove HoF
+		{Height: 84, Wingspan: 88, Weight: 250, Steal: 72, Block: 82}, // Glove Silver, Anchor Gold
+		{Height: 88, Wingspan: 92, Weight: 280, Steal: 40, Block: 96}, // Anchor Legendary
+	}
+	baseline := &attributes.Build{Height: 84, Wingspan: 88, Weight: 250}
+
+	targets := []builds.Target{
+		{Badge: "Glove", Tier: badges.BadgeTierGold, Weight: 2},
+		{Badge: "anchor", Tier: badges.BadgeTierGold},
+	}
+	candidates, err := builds.Optimize(calc, caps, targets, baseline, 2)
+	require.NoError(t, err)
+	require.Len(t, candidates, 2)
+
+	// Balanced build: 2 × 2/3 + 1 = 2.33; Steal build: 2 + 0 = 2
+	assert.Equal(t, *baseline, candidates[0].Build)
+	assert.InDelta(t, 2.0*2/3+1, candidates[0].Score, 1e-9)
+	assert.Empty(t, candidates[0].Gained)
+	assert.Empty(t, candidates[0].Lost)
+
+	assert.Equal(t, attributes.Build{Height: 80, Wingspan: 84, Weight: 220}, candidates[1].Build)
+	assert.InDelta(t, 2.0, candidates[1].Score, 1e-9)
+	assert.Equal(t, []builds.BadgeChange{
+		{Badge: "Disruptor", From: badges.BadgeTierGold, To: badges.BadgeTierHallOfFame},
+		{Badge: "Glove", From: badges.BadgeTierSilver, To: badges.BadgeTierHallOfFame},
+	}, candidates[1].Gained)
+	assert.Equal(t, []builds.BadgeChange{{Badge: "Anchor", From: badges.BadgeTierGold, To: badges.BadgeTierNone}}, candidates[1].Lost)
+	assert.Equal(t, "Glove Silver → Hall of Fame", candidates[1].Gained[1].String())
+}
+
+// TestOptimize_Errors tests targets and baseline are validated
+func TestOptimize_Errors(t *testing.T) {
+	calc := testCalculator(t)
+	caps := []scraper.AttributeCaps{{Height: 84, Wingspan: 88, Weight: 250}}
+
+	_, err := builds.Optimize(calc, caps, nil, nil, 1)
+	assert.Error(t, err)
+
+	_, err = builds.Optimize(calc, caps, []builds.Target{{Badge: "Nonexistent", Tier: badges.BadgeTierGold}}, nil, 1)
+	assert.Error(t, err)
+
+	_, err = builds.Optimize(calc, caps, []builds.Target{{Badge: "Glove", Tier: badges.BadgeTierNone}}, nil, 1)
+	assert.Error(t, err)
+
+	_, err = builds.Optimize(calc, caps, []builds.Target{{Badge: "Glove", Tier: badges.BadgeTierGold}}, &attributes.Build{Height: 60, Wingspan: 60, Weight: 150}, 1)
+	assert.ErrorContains(t, err, "not a legal Center build")
+}
+
+// TestOptimize_Required tests builds below a required tier are left out
+func TestOptimize_Required(t *testing.T) {
+	calc := testCalculator(t)
+
+	caps := []scraper.AttributeCaps{
+		{Height: 80, Wingspan: 84, Weight: 220, Steal: 91, Block: 40}, // Glove HoF
+		{Height: 84, Wingspan: 88, Weight: 250, Steal: 72, Block: 82}, // Glove Silver, Anchor Gold
+		{Height: 88, Wingspan: 92, Weight: 280, Steal: 40, Block: 96}, // Anchor Legendary
+	}
+
+	// As a preference, Glove Gold ranks the Steal build first but keeps the others
+	targets := []builds.Target{
+		{Badge: "Glove", Tier: badges.BadgeTierGold, Weight: 2},
+		{Badge: "Anchor", Tier: badges.BadgeTierGold},
+	}
+	candidates, err := builds.Optimize(calc, caps, targets, nil, 0)
+	require.NoError(t, err)
+	assert.Len(t, candidates, 3)
+
+	// As a hard minimum, only builds with Glove Gold or better remain
+	targets[0].Required = true
+	candidates, err = builds.Optimize(calc, caps, targets, nil, 0)
+	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+	assert.Equal(t, attributes.Build{Height: 80, Wingspan: 84, Weight: 220}, candidates[0].Build)
+
+	// No build meets both minimums
+	targets[1].Required = true
+	candidates, err = builds.Optimize(calc, caps, targets, nil, 0)
+	require.NoError(t, err)
+	assert.Empty(t, candidates)
+}
+
+// TestOptimize_OffGridBaseline tests a baseline outside caps is evaluated from the calculators
+func TestOptimize_OffGridBaseline(t *testing.T) {
+	calc, err := badges.NewCalculator()
+	require.NoError(t, err)
+
+	caps := builds.CenterCaps(5)
+	baseline := &attributes.Build{Height: 84, Wingspan: 87, Weight: 262} // 5 lb steps from 215 skip 262
+	for _, c := range caps {
+		require.NotEqual(t, *baseline, attributes.Build{Height: c.Height, Wingspan: c.Wingspan, Weight: c.Weight})
+	}
+
+	targets := []builds.Target{{Badge: "Posterizer", Tier: badges.BadgeTierGold}}
+	candidates, err := builds.Optimize(calc, caps, targets, baseline, 3)
+	require.NoError(t, err)
+	require.NotEmpty(t, candidates)
+
+	// Changes are measured against the baseline's own caps
+	base := attributes.CenterCaps(baseline.Height, baseline.Weight, baseline.Wingspan)
+	for _, c := range candidates {
+		for _, change := range append(c.Gained, c.Lost...) {
+			from, err := calc.GetBadgeTier(change.Badge, base)
+			require.NoError(t, err)
+			assert.Equal(t, from, change.From, "%s on %s", change.Badge, c.Build)
+		}
+	}
+}
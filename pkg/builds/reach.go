@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+// Package builds answers build-level questions across the badge and attribute
+// systems, such as which builds can reach a badge tier.
+package builds
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/jredh-dev/nba2k26/pkg/attributes"
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+)
+
+// BadgeReach summarizes which builds reach each tier of a badge
+type BadgeReach struct {
+	// Badge is the badge name
+	Badge string
+	// Total is the number of builds evaluated
+	Total int
+	// Tiers is indexed by BadgeTier (None is left empty); a build that reaches Gold
+	// also counts toward Bronze and Silver
+	Tiers [badges.BadgeTierLegendary + 1]TierReach
+}
+
+// TierReach is the region of builds that reach one tier
+type TierReach struct {
+	// Tier is the badge tier
+	Tier badges.BadgeTier
+	// Builds is the number of builds that reach the tier
+	Builds int
+	// Heights summarizes the qualifying builds per height, shortest first
+	Heights []HeightReach
+	// Best is the qualifying build with the most attribute points across the
+	// badge's requirements (nil if no build reaches the tier)
+	Best *attributes.Build
+	// BestPoints is Best's total across the badge's requirement attributes
+	BestPoints int
+}
+
+// HeightReach is the wingspan and weight range of the qualifying builds at one height
+// The ranges bound the qualifying builds; when Builds is less than the number of
+// builds inside the ranges, some combinations within them do not qualify.
+type HeightReach struct {
+	Height      int
+	MinWingspan int
+	MaxWingspan int
+	MinWeight   int
+	MaxWeight   int
+	// Builds is the number of qualifying builds at this height
+	Builds int
+	// Total is the number of builds evaluated at this height
+	Total int
+}
+
+// String formats the range as 7'0": wingspan 7'2"-7'6", 215-245 lbs (38/60 builds)
+func (h HeightReach) String() string {
+	return fmt.Sprintf("%s: wingspan %s-%s, %d-%d lbs (%d/%d builds)",
+		attributes.InchesToLength(h.Height),
+		attributes.InchesToLength(h.MinWingspan), attributes.InchesToLength(h.MaxWingspan),
+		h.MinWeight, h.MaxWeight, h.Builds, h.Total)
+}
+
+// ReachBadge evaluates a badge for every build and summarizes the builds reaching each tier
+// caps can come from the calculators (CenterCaps) or a scraped dataset.
+func ReachBadge(calc *badges.Calculator, caps []scraper.AttributeCaps, badgeName string) (*BadgeReach, error) {
+	badge, err := calc.Badge(badgeName)
+	if err != nil {
+		return nil, err
+	}
+
+	var attrNames []string
+	for _, req := range badge.Requirements {
+		if !slices.Contains(attrNames, req.Attribute) {
+			attrNames = append(attrNames, req.Attribute)
+		}
+	}
+
+	reach := &BadgeReach{Badge: badge.Name, Total: len(caps)}
+	heights := make([]map[int]*HeightReach, badges.BadgeTierLegendary+1) // tier → height → range
+	totals := make(map[int]int)                                          // height → builds evaluated
+	for t := range reach.Tiers {
+		reach.Tiers[t].Tier = badges.BadgeTier(t)
+		heights[t] = make(map[int]*HeightReach)
+	}
+
+	for i := range caps {
+		c := &caps[i]
+		totals[c.Height]++
+
+		tier, err := calc.GetBadgeTier(badgeName, c)
+		if err != nil {
+			return nil, err
+		}
+
+		points := 0
+		for _, name := range attrNames {
+			points += badges.AttributeValue(name, c)
+		}
+
+		for t := badges.BadgeTierBronze; t <= tier; t++ {
+			tr := &reach.Tiers[t]
+			tr.Builds++
+			if tr.Best == nil || points > tr.BestPoints {
+				tr.Best = &attributes.Build{Height: c.Height, Wingspan: c.Wingspan, Weight: c.Weight}
+				tr.BestPoints = points
+			}
+
+			h, ok := heights[t][c.Height]
+			if !ok {
+				h = &HeightReach{
+					Height:      c.Height,
+					MinWingspan: c.Wingspan,
+					MaxWingspan: c.Wingspan,
+					MinWeight:   c.Weight,
+					MaxWeight:   c.Weight,
+				}
+				heights[t][c.Height] = h
+			}
+			h.MinWingspan = min(h.MinWingspan, c.Wingspan)
+			h.MaxWingspan = max(h.MaxWingspan, c.Wingspan)
+			h.MinWeight = min(h.MinWeight, c.Weight)
+			h.MaxWeight = max(h.MaxWeight, c.Weight)
+			h.Builds++
+		}
+	}
+
+	for t := range reach.Tiers {
+		for _, h := range heights[t] {
+			h.Total = totals[h.Height]
+			reach.Tiers[t].Heights = append(reach.Tiers[t].Heights, *h)
+		}
+		sort.Slice(reach.Tiers[t].Heights, func(i, j int) bool {
+			return reach.Tiers[t].Heights[i].Height < reach.Tiers[t].Heights[j].Height
+		})
+	}
+
+	return reach, nil
+}
+
+// CenterCaps returns the calculated caps of every legal Center build
+// Weights step from each height's minimum by weightStep lbs.
+func CenterCaps(weightStep int) []scraper.AttributeCaps {
+	return attributes.CenterCapTable().EvaluateAll(weightStep)
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Badge System
+
+package builds_test
+
+import (
+	"testing"
+
+	"github.com/jredh-dev/nba2k26/pkg/attributes"
+	"github.com/jredh-dev/nba2k26/pkg/badges"
+	"github.com/jredh-dev/nba2k26/pkg/builds"
+	"github.com/jredh-dev/nba2k26/pkg/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSheet is the badge package's shared test sheet
+const testSheet = "../badges/testdata/sheet.json"
+
+// testCalculator returns a calculator loaded from testSheet
+func testCalculator(t *testing.T) *badges.Calculator {
+	t.Helper()
+	calc, err := badges.NewCalculator(badges.WithRequirementsFile(testSheet))
+	require.NoError(t, err)
+	return calc
+}
+
+// TestReachBadge tests qualifying builds are grouped per height with the best build per tier
+func TestReachBadge(t *testing.T) {
+	calc := testCalculator(t)
+
+	caps := []scraper.AttributeCaps{
+		{Height: 84, Wingspan: 86, Weight: 240, Steal: 82},
+		{Height: 84, Wingspan: 88, Weight: 250, Steal: 71},
+		{Height: 84, Wingspan: 90, Weight: 260, Steal: 50},
+		{Height: 85, Wingspan: 87, Weight: 230, Steal: 65},
+	}
+
+	reach, err := builds.ReachBadge(calc, caps, "Glove")
+	require.NoError(t, err)
+	assert.Equal(t, "Glove", reach.Badge)
+	assert.Equal(t, 4, reach.Total)
+
+	bronze := reach.Tiers[badges.BadgeTierBronze]
+	assert.Equal(t, 3, bronze.Builds)
+	require.Len(t, bronze.Heights, 2)
+	assert.Equal(t, builds.HeightReach{Height: 84, MinWingspan: 86, MaxWingspan: 88, MinWeight: 240, MaxWeight: 250, Builds: 2, Total: 3}, bronze.Heights[0])
+	assert.Equal(t, 85, bronze.Heights[1].Height)
+	assert.Equal(t, &attributes.Build{Height: 84, Wingspan: 86, Weight: 240}, bronze.Best)
+	assert.Equal(t, 82, bronze.BestPoints)
+
+	gold := reach.Tiers[badges.BadgeTierGold]
+	assert.Equal(t, 1, gold.Builds)
+	assert.Equal(t, `7'0": wingspan 7'2"-7'2", 240-240 lbs (1/3 builds)`, gold.Heights[0].String())
+
+	hof := reach.Tiers[badges.BadgeTierHallOfFame]
+	assert.Zero(t, hof.Builds)
+	assert.Nil(t, hof.Best)
+	assert.Empty(t, hof.Heights)
+
+	_, err = builds.ReachBadge(calc, caps, "Nonexistent")
+	assert.Error(t, err)
+}
+
+// TestReachBadge_Calculators tests the calculator grid covers every legal Center build
+func TestReachBadge_Calculators(t *testing.T) {
+	calc, err := badges.NewCalculator()
+	require.NoError(t, err)
+
+	caps := builds.CenterCaps(5)
+	reach, err := builds.ReachBadge(calc, caps, "Dimer")
+	require.NoError(t, err)
+	assert.Equal(t, len(attributes.LegalCenterBuilds(5)), reach.Total)
+
+	for tier := badges.BadgeTierBronze; tier < badges.BadgeTierLegendary; tier++ {
+		assert.GreaterOrEqual(t, reach.Tiers[tier].Builds, reach.Tiers[tier+1].Builds, "higher tiers are a subset")
+	}
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+package scraper
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// AttributeNames lists the 21 rated attributes in builder order.
+// Names match the NBA2KLab badge requirement sheet (e.g., "Driving Dunk").
+var AttributeNames = []string{
+	"Close Shot",
+	"Driving Layup",
+	"Driving Dunk",
+	"Standing Dunk",
+	"Post Control",
+	"Mid-Range Shot",
+	"Three-Point Shot",
+	"Free Throw",
+	"Pass Accuracy",
+	"Ball Handle",
+	"Speed With Ball",
+	"Interior Defense",
+	"Perimeter Defense",
+	"Steal",
+	"Block",
+	"Offensive Rebound",
+	"Defensive Rebound",
+	"Speed",
+	"Agility",
+	"Strength",
+	"Vertical",
+}
+
+// Get returns the value of the named attribute
+// The second return value is false if the name is not a known attribute
+func (a *AttributeCaps) Get(name string) (int, bool) {
+	f := a.field(name)
+	if f == nil {
+		return 0, false
+	}
+	return *f, true
+}
+
+// Set sets the named attribute
+// Returns false (and changes nothing) if the name is not a known attribute
+func (a *AttributeCaps) Set(name string, value int) bool {
+	f := a.field(name)
+	if f == nil {
+		return false
+	}
+	*f = value
+	return true
+}
+
+// field returns a pointer to the named attribute's field, or nil if unknown
+func (a *AttributeCaps) field(name string) *int {
+	switch name {
+	case "Close Shot":
+		return &a.CloseShot
+	case "Driving Layup":
+		return &a.DrivingLayup
+	case "Driving Dunk":
+		return &a.DrivingDunk
+	case "Standing Dunk":
+		return &a.StandingDunk
+	case "Post Control":
+		return &a.PostControl
+	case "Mid-Range Shot":
+		return &a.MidRangeShot
+	case "Three-Point Shot":
+		return &a.ThreePointShot
+	case "Free Throw":
+		return &a.FreeThrow
+	case "Pass Accuracy":
+		return &a.PassAccuracy
+	case "Ball Handle":
+		return &a.BallHandle
+	case "Speed With Ball":
+		return &a.SpeedWithBall
+	case "Interior Defense":
+		return &a.InteriorDefense
+	case "Perimeter Defense":
+		return &a.PerimeterDefense
+	case "Steal":
+		return &a.Steal
+	case "Block":
+		return &a.Block
+	case "Offensive Rebound":
+		return &a.OffensiveRebound
+	case "Defensive Rebound":
+		return &a.DefensiveRebound
+	case "Speed":
+		return &a.Speed
+	case "Agility":
+		return &a.Agility
+	case "Strength":
+		return &a.Strength
+	case "Vertical":
+		return &a.Vertical
+	default:
+		return nil
+	}
+}
+
+// LoadCaps reads a JSON array of scraped builds (e.g. data/Center_caps.json)
+func LoadCaps(path string) ([]AttributeCaps, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading data file: %w", err)
+	}
+
+	var caps []AttributeCaps
+	if err := json.Unmarshal(data, &caps); err != nil {
+		return nil, fmt.Errorf("parsing JSON: %w", err)
+	}
+	return caps, nil
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+package scraper
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAttributeCaps_GetSet verifies every named attribute round-trips through Set and Get
+func TestAttributeCaps_GetSet(t *testing.T) {
+	var caps AttributeCaps
+	for i, name := range AttributeNames {
+		assert.True(t, caps.Set(name, 50+i), name)
+	}
+	for i, name := range AttributeNames {
+		value, ok := caps.Get(name)
+		assert.True(t, ok, name)
+		assert.Equal(t, 50+i, value, name)
+	}
+
+	assert.False(t, caps.Set("Dunk", 99))
+	_, ok := caps.Get("Dunk")
+	assert.False(t, ok)
+}
+
+// TestLoadCaps verifies scraped builds are read from a JSON array
+func TestLoadCaps(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "caps.json")
+	require.NoError(t, os.WriteFile(path, []byte(`[{"position": "Center", "height": 84, "wingspan": 87, "weight": 250, "driving_dunk": 86}]`), 0o644))
+
+	caps, err := LoadCaps(path)
+	require.NoError(t, err)
+	assert.Equal(t, []AttributeCaps{{Position: "Center", Height: 84, Wingspan: 87, Weight: 250, DrivingDunk: 86}}, caps)
+
+	_, err = LoadCaps(filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"height": 84}`), 0o644))
+	_, err = LoadCaps(path)
+	assert.Error(t, err)
+}
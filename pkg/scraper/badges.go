@@ -0,0 +1,263 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+package scraper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// BadgeRequirement is one row of the badge requirement sheet in the schema
+// pkg/badges loads (pkg/badges/data/badge_requirements.json)
+type BadgeRequirement struct {
+	Category  string `json:"Category"`
+	Badge     string `json:"Badge"`
+	Type      string `json:"Type"`
+	Attribute string `json:"Attribute"`
+	Bronze    int    `json:"Bronze"`
+	Silver    int    `json:"Silver"`
+	Gold      int    `json:"Gold"`
+	// HoF and Legend are written as "" when the requirement tops out below them
+	HoF       Threshold `json:"HoF"`
+	Legend    Threshold `json:"Legend"`
+	MinHeight string    `json:"Min_Height"` // Format: "6'3"
+	MaxHeight string    `json:"Max_Height"` // Format: "7'4"
+	ID        string    `json:"id"`
+	Group     string    `json:"Group,omitempty"`
+	GroupType string    `json:"Group_Type,omitempty"`
+}
+
+// Threshold is a tier threshold that may be empty (0)
+type Threshold int
+
+// MarshalJSON writes an empty threshold as ""
+func (t Threshold) MarshalJSON() ([]byte, error) {
+	if t == 0 {
+		return []byte(`""`), nil
+	}
+	return []byte(strconv.Itoa(int(t))), nil
+}
+
+// UnmarshalJSON reads a number, a numeric string, "", or null
+func (t *Threshold) UnmarshalJSON(data []byte) error {
+	n, err := cellInt(data)
+	if err != nil {
+		return err
+	}
+	*t = Threshold(n)
+	return nil
+}
+
+// badgeFields maps normalized sheet column names to BadgeRequirement fields
+var badgeFields = map[string]string{
+	"category":   "Category",
+	"badge":      "Badge",
+	"name":       "Badge",
+	"badgename":  "Badge",
+	"type":       "Type",
+	"attribute":  "Attribute",
+	"bronze":     "Bronze",
+	"silver":     "Silver",
+	"gold":       "Gold",
+	"hof":        "HoF",
+	"halloffame": "HoF",
+	"legend":     "Legend",
+	"legendary":  "Legend",
+	"minheight":  "Min_Height",
+	"maxheight":  "Max_Height",
+	"id":         "id",
+	"badgeid":    "id",
+	"group":      "Group",
+	"grouptype":  "Group_Type",
+}
+
+// FetchBadgeRequirements fetches the badge requirement sheet and normalizes it
+func (c *Client) FetchBadgeRequirements() ([]BadgeRequirement, error) {
+	body, err := c.post(c.badgesURL, apiRequest{Filters: []filter{}, Year: c.year})
+	if err != nil {
+		return nil, err
+	}
+	return NormalizeBadgeRequirements(body)
+}
+
+// NormalizeBadgeRequirements converts a badge requirement sheet into the loader's schema
+// The sheet may be a JSON array of rows or an object with a "results" array.
+// Column names are matched ignoring case and punctuation ("hall_of_fame",
+// "HoF"), thresholds may be numbers or numeric strings, heights may be inches
+// or "6'3" strings, and a missing id is derived from the badge name.
+func NormalizeBadgeRequirements(data []byte) ([]BadgeRequirement, error) {
+	var rows []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rows); err != nil {
+		var wrapped struct {
+			Results []map[string]json.RawMessage `json:"results"`
+		}
+		if err := json.Unmarshal(data, &wrapped); err != nil || wrapped.Results == nil {
+			return nil, fmt.Errorf("failed to parse badge requirements: expected an array of rows or a results array")
+		}
+		rows = wrapped.Results
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no badge requirements returned")
+	}
+
+	result := make([]BadgeRequirement, 0, len(rows))
+	for i, row := range rows {
+		req, err := normalizeBadgeRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", i+1, err)
+		}
+		result = append(result, req)
+	}
+	return result, nil
+}
+
+// normalizeBadgeRow converts one sheet row into the loader's schema
+func normalizeBadgeRow(row map[string]json.RawMessage) (BadgeRequirement, error) {
+	cells := make(map[string]json.RawMessage, len(row))
+	for key, value := range row {
+		if field, ok := badgeFields[columnKey(key)]; ok {
+			cells[field] = value
+		}
+	}
+
+	var req BadgeRequirement
+	var err error
+	text := func(field string) string {
+		if err != nil {
+			return ""
+		}
+		var s string
+		s, err = cellString(cells[field])
+		if err != nil {
+			err = fmt.Errorf("%s: %w", field, err)
+		}
+		return s
+	}
+	number := func(field string) int {
+		if err != nil {
+			return 0
+		}
+		var n int
+		n, err = cellInt(cells[field])
+		if err != nil {
+			err = fmt.Errorf("%s: %w", field, err)
+		}
+		return n
+	}
+	height := func(field string) string {
+		if err != nil {
+			return ""
+		}
+		var h string
+		h, err = cellHeight(cells[field])
+		if err != nil {
+			err = fmt.Errorf("%s: %w", field, err)
+		}
+		return h
+	}
+
+	req.Category = text("Category")
+	req.Badge = text("Badge")
+	req.Type = text("Type")
+	req.Attribute = text("Attribute")
+	req.Bronze = number("Bronze")
+	req.Silver = number("Silver")
+	req.Gold = number("Gold")
+	req.HoF = Threshold(number("HoF"))
+	req.Legend = Threshold(number("Legend"))
+	req.MinHeight = height("Min_Height")
+	req.MaxHeight = height("Max_Height")
+	req.ID = text("id")
+	req.Group = text("Group")
+	req.GroupType = text("Group_Type")
+	if err != nil {
+		return BadgeRequirement{}, err
+	}
+
+	if req.Badge == "" || req.Attribute == "" {
+		return BadgeRequirement{}, fmt.Errorf("badge and attribute are required")
+	}
+	if req.ID == "" {
+		req.ID = strings.ReplaceAll(req.Badge, " ", "")
+	}
+	return req, nil
+}
+
+// WriteBadgeRequirements writes the sheet in the layout of the canonical data file
+func WriteBadgeRequirements(path string, reqs []BadgeRequirement) error {
+	data, err := json.MarshalIndent(reqs, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal badge requirements: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write badge requirements: %w", err)
+	}
+	return nil
+}
+
+// columnKey lowercases a column name and drops everything but letters and digits
+func columnKey(name string) string {
+	var sb strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return sb.String()
+}
+
+// cellString reads a string cell, accepting numbers and treating null as ""
+func cellString(data json.RawMessage) (string, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || string(data) == "null" {
+		return "", nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return strings.TrimSpace(s), nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return "", fmt.Errorf("expected text, got %s", data)
+	}
+	return n.String(), nil
+}
+
+// cellInt reads a numeric cell, accepting numeric strings and treating "" and null as 0
+func cellInt(data []byte) (int, error) {
+	s, err := cellString(data)
+	if err != nil || s == "" {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f != float64(int(f)) {
+		return 0, fmt.Errorf("expected a whole number, got %s", data)
+	}
+	return int(f), nil
+}
+
+// cellHeight reads a height as "6'3", accepting inches and strings like 6'3" or 6-3
+func cellHeight(data json.RawMessage) (string, error) {
+	s, err := cellString(data)
+	if err != nil || s == "" {
+		return "", err
+	}
+	if inches, err := strconv.Atoi(s); err == nil {
+		return fmt.Sprintf("%d'%d", inches/12, inches%12), nil
+	}
+
+	var feet, inches int
+	trimmed := strings.TrimSuffix(s, `"`)
+	for _, sep := range []string{"'", "-"} {
+		if n, err := fmt.Sscanf(trimmed, "%d"+sep+"%d", &feet, &inches); err == nil && n == 2 {
+			return fmt.Sprintf("%d'%d", feet, inches), nil
+		}
+	}
+	return "", fmt.Errorf("invalid height %q", s)
+}
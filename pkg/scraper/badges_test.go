@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: AGPL-3.0
+// Copyright (C) 2025 NBA 2K26 Attribute System
+
+package scraper
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// badgeSheetResponse uses the column spellings and cell types NormalizeBadgeRequirements accepts
+const badgeSheetResponse = `{"results": [
+	{"category": "Inside Scoring", "badge_name": "Post-Up Poet", "type": "Primary", "attribute": "Post Control", "bronze": "60", "silver": 70, "gold": 80, "hall_of_fame": 90, "legendary": 95, "min_height": 69, "max_height": "7'4\"", "badge_id": "Post-UpPoet"},
+	{"Category": "Defense", "Badge": "Glove", "Type": "Primary", "Attribute": "Steal", "Bronze": 55, "Silver": 65, "Gold": 75, "HoF": "", "Legend": null, "Min_Height": "6-0", "Max_Height": "", "Notes": "ignored"}
+]}`
+
+// TestFetchBadgeRequirements tests the sheet is requested through the client and normalized
+func TestFetchBadgeRequirements(t *testing.T) {
+	var gotAuth string
+	var gotBody apiRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &gotBody)
+		_, _ = io.WriteString(w, badgeSheetResponse)
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBadgesURL(server.URL), WithAuthToken("test-token"))
+	reqs, err := client.FetchBadgeRequirements()
+	require.NoError(t, err)
+
+	assert.Equal(t, "Bearer test-token", gotAuth)
+	assert.Equal(t, GameYear, gotBody.Year)
+	assert.Equal(t, []BadgeRequirement{
+		{Category: "Inside Scoring", Badge: "Post-Up Poet", Type: "Primary", Attribute: "Post Control", Bronze: 60, Silver: 70, Gold: 80, HoF: 90, Legend: 95, MinHeight: "5'9", MaxHeight: "7'4", ID: "Post-UpPoet"},
+		{Category: "Defense", Badge: "Glove", Type: "Primary", Attribute: "Steal", Bronze: 55, Silver: 65, Gold: 75, MinHeight: "6'0", ID: "Glove"},
+	}, reqs)
+
+	path := filepath.Join(t.TempDir(), "badge_requirements.json")
+	require.NoError(t, WriteBadgeRequirements(path, reqs[1:]))
+	written, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(written), `"HoF": "",`)
+}
+
+// TestFetchBadgeRequirements_Errors tests HTTP failures and malformed sheets are reported
+func TestFetchBadgeRequirements_Errors(t *testing.T) {
+	status := http.StatusInternalServerError
+	body := "down"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, body)
+	}))
+	defer server.Close()
+	client := NewClient(WithBadgesURL(server.URL))
+
+	_, err := client.FetchBadgeRequirements()
+	assert.ErrorContains(t, err, "status 500")
+
+	status = http.StatusOK
+	for _, body = range []string{
+		`{"error": "unknown"}`,
+		`[]`,
+		`[{"Badge": "Glove", "Attribute": "Steal", "Bronze": "high"}]`,
+		`[{"Badge": "Glove", "Attribute": "Steal", "Min_Height": "tall"}]`,
+		`[{"Badge": "Glove"}]`,
+	} {
+		_, err := client.FetchBadgeRequirements()
+		assert.Error(t, err, body)
+	}
+}
+
+// TestNormalizeBadgeRequirements_Canonical tests the canonical data file round-trips byte for byte
+func TestNormalizeBadgeRequirements_Canonical(t *testing.T) {
+	original, err := os.ReadFile("../badges/data/badge_requirements.json")
+	require.NoError(t, err)
+
+	reqs, err := NormalizeBadgeRequirements(original)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "badge_requirements.json")
+	require.NoError(t, WriteBadgeRequirements(path, reqs))
+	written, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, string(original), string(written))
+}